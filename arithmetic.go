@@ -6,6 +6,7 @@ package uint256
 
 import (
 	"math/bits"
+	"strconv"
 )
 
 // Add sets z to the sum x+y
@@ -109,6 +110,195 @@ func (z *Uint) Div(x, y *Uint) *Uint {
 	return z.Set(&quot)
 }
 
+// SDiv interprets n and d as two's complement signed integers,
+// does a signed division on the two operands and sets z to the result.
+// The quotient is truncated towards zero, matching the EVM SDIV opcode:
+// if d == 0, z is set to 0, and MinInt256 / -1 wraps to MinInt256.
+func (z *Uint) SDiv(n, d *Uint) *Uint {
+	if n.Sign() > 0 {
+		if d.Sign() > 0 {
+			// pos / pos
+			return z.Div(n, d)
+		}
+		// pos / neg
+		z.Div(n, new(Uint).Neg(d))
+		return z.Neg(z)
+	}
+
+	if d.Sign() < 0 {
+		// neg / neg
+		return z.Div(new(Uint).Neg(n), new(Uint).Neg(d))
+	}
+	// neg / pos
+	z.Div(new(Uint).Neg(n), d)
+	return z.Neg(z)
+}
+
+// SMod interprets x and y as two's complement signed integers,
+// sets z to (sign x) * { abs(x) modulus abs(y) }, and returns z.
+// This matches the EVM SMOD opcode.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Uint) SMod(x, y *Uint) *Uint {
+	xs := x.Sign()
+
+	// abs x
+	if xs == -1 {
+		x = new(Uint).Neg(x)
+	}
+	// abs y
+	if y.Sign() == -1 {
+		y = new(Uint).Neg(y)
+	}
+	z.Mod(x, y)
+	if xs == -1 {
+		z.Neg(z)
+	}
+	return z
+}
+
+// MulDiv sets z to floor(x*y/d) and returns z.
+// The product x*y is computed with 512-bit precision, so the result is exact
+// as long as the quotient fits in 256 bits. Otherwise the quotient is truncated
+// to its lower 256 bits; use MulDivOverflow to detect this case.
+// If d == 0, z is set to 0
+func (z *Uint) MulDiv(x, y, d *Uint) *Uint {
+	z, _ = z.MulDivOverflow(x, y, d)
+	return z
+}
+
+// MulDivOverflow sets z to floor(x*y/d), and returns z and whether the quotient
+// overflowed 256 bits.
+// If d == 0, z is set to 0 and no overflow is reported (OBS: differs from the Uniswap FullMath.mulDiv)
+func (z *Uint) MulDivOverflow(x, y, d *Uint) (*Uint, bool) {
+	if x.IsZero() || y.IsZero() || d.IsZero() {
+		return z.Clear(), false
+	}
+	p := umul(x, y)
+
+	var quot [8]uint64
+	udivrem(quot[:], p[:], d)
+
+	copy(z.arr[:], quot[:4])
+	return z, (quot[4] | quot[5] | quot[6] | quot[7]) != 0
+}
+
+// RoundingMode determines how the quotient of a division is rounded
+// when the division is inexact.
+type RoundingMode byte
+
+const (
+	Floor    RoundingMode = iota // round towards zero
+	Ceil                         // round away from zero
+	HalfUp                       // round to nearest, ties away from zero
+	HalfEven                     // round to nearest, ties to even
+)
+
+// DivRound sets z to the quotient x/y rounded according to mode, and returns z.
+// If y == 0, z is set to 0
+func (z *Uint) DivRound(x, y *Uint, mode RoundingMode) *Uint {
+	if y.IsZero() {
+		return z.Clear()
+	}
+	var quot, rem Uint
+	quot.DivMod(x, y, &rem)
+	// quot < 2^256 - 1 whenever rem != 0, so the increment can not wrap.
+	if roundUp(&quot, &rem, y, mode) {
+		quot.Add(&quot, One())
+	}
+	return z.Set(&quot)
+}
+
+// DivRoundUp sets z to the quotient x/y rounded up, and returns z.
+// If y == 0, z is set to 0
+func (z *Uint) DivRoundUp(x, y *Uint) *Uint {
+	return z.DivRound(x, y, Ceil)
+}
+
+// MulDivRound sets z to x*y/d rounded according to mode, and returns z.
+// The product x*y is computed with 512-bit precision. If the rounded quotient
+// does not fit in 256 bits it is truncated; use MulDivRoundOverflow to detect this case.
+// If d == 0, z is set to 0
+func (z *Uint) MulDivRound(x, y, d *Uint, mode RoundingMode) *Uint {
+	z, _ = z.MulDivRoundOverflow(x, y, d, mode)
+	return z
+}
+
+// MulDivRoundOverflow sets z to x*y/d rounded according to mode, and returns z
+// and whether the rounded quotient overflowed 256 bits.
+// If d == 0, z is set to 0 and no overflow is reported
+func (z *Uint) MulDivRoundOverflow(x, y, d *Uint, mode RoundingMode) (*Uint, bool) {
+	if x.IsZero() || y.IsZero() || d.IsZero() {
+		return z.Clear(), false
+	}
+	p := umul(x, y)
+
+	var (
+		quot [8]uint64
+		q    Uint
+	)
+	rem := udivrem(quot[:], p[:], d)
+	copy(q.arr[:], quot[:4])
+
+	overflow := (quot[4] | quot[5] | quot[6] | quot[7]) != 0
+	if roundUp(&q, &rem, d, mode) {
+		var carry bool
+		_, carry = q.AddOverflow(&q, One())
+		overflow = overflow || carry
+	}
+	return z.Set(&q), overflow
+}
+
+// MulDivRoundingUp sets z to x*y/d rounded up, and returns z.
+// It is the counterpart of the Uniswap FullMath.mulDivRoundingUp.
+// If d == 0, z is set to 0
+func (z *Uint) MulDivRoundingUp(x, y, d *Uint) *Uint {
+	return z.MulDivRound(x, y, d, Ceil)
+}
+
+// roundUp reports whether the quotient quot of a division by d, which left the
+// remainder rem, must be incremented to honour the rounding mode.
+// Only the least significant bit of quot is inspected.
+func roundUp(quot, rem, d *Uint, mode RoundingMode) bool {
+	if rem.IsZero() {
+		return false
+	}
+	switch mode {
+	case Ceil:
+		return true
+	case HalfUp, HalfEven:
+		// Compare 2*rem with d without overflowing: rem <=> d - rem
+		var diff Uint
+		diff.Sub(d, rem)
+		switch rem.Cmp(&diff) {
+		case 1:
+			return true
+		case 0:
+			return mode == HalfUp || quot.arr[0]&1 == 1
+		}
+	}
+	return false
+}
+
+// AddMod calculates the modulo-m addition of x and y and returns z.
+// The intermediate sum is kept with 257-bit precision, so the result is exact
+// even when x+y overflows 256 bits.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Uint) AddMod(x, y, m *Uint) *Uint {
+	if m.IsZero() {
+		return z.Clear()
+	}
+	if z == m { // z is an alias for m and will be overwritten by AddOverflow before m is read
+		m = m.Clone()
+	}
+	if _, overflow := z.AddOverflow(x, y); overflow {
+		sum := [5]uint64{z.arr[0], z.arr[1], z.arr[2], z.arr[3], 1}
+		var quot [5]uint64
+		*z = udivrem(quot[:], sum[:], m)
+		return z
+	}
+	return z.Mod(z, m)
+}
+
 // MulMod calculates the modulo-m multiplication of x and y and
 // returns z.
 // If m == 0, z is set to 0 (OBS: differs from the big.Int)
@@ -230,6 +420,189 @@ func (z *Uint) Exp(base, exponent *Uint) *Uint {
 	return z.Set(&res)
 }
 
+// SaturatingAdd sets z to the sum x+y, clamped to MaxUint256 (2^256-1), and returns z.
+func (z *Uint) SaturatingAdd(x, y *Uint) *Uint {
+	if _, overflow := z.AddOverflow(x, y); overflow {
+		return z.SetAllOne()
+	}
+	return z
+}
+
+// SaturatingSub sets z to the difference x-y, clamped to 0, and returns z.
+func (z *Uint) SaturatingSub(x, y *Uint) *Uint {
+	if _, overflow := z.SubOverflow(x, y); overflow {
+		return z.Clear()
+	}
+	return z
+}
+
+// SaturatingMul sets z to the product x*y, clamped to MaxUint256 (2^256-1), and returns z.
+func (z *Uint) SaturatingMul(x, y *Uint) *Uint {
+	if _, overflow := z.MulOverflow(x, y); overflow {
+		return z.SetAllOne()
+	}
+	return z
+}
+
+// ExpOverflow sets z = base**exponent mod 2**256, and returns z and whether overflow occurred.
+// Most inputs are decided from bit lengths alone: with b = base.BitLen(),
+// 2**((b-1)*exponent) <= base**exponent < 2**(b*exponent).
+// Only when the two bounds straddle 2**256 is the power computed with checked multiplications.
+func (z *Uint) ExpOverflow(base, exponent *Uint) (*Uint, bool) {
+	// 0 and 1 are fixed points, so only bases >= 2 can overflow
+	if base.LtUint64(2) || exponent.IsZero() {
+		return z.Exp(base, exponent), false
+	}
+	// 2**257 <= base**exponent
+	if !exponent.IsUint64() || exponent.arr[0] > 256 {
+		return z.Exp(base, exponent), true
+	}
+
+	var (
+		e      = exponent.arr[0]
+		bitLen = uint64(base.BitLen())
+	)
+	if bitLen*e <= 256 {
+		return z.Exp(base, exponent), false
+	}
+	if (bitLen-1)*e >= 256 {
+		return z.Exp(base, exponent), true
+	}
+
+	var (
+		res        = Uint{arr: [4]uint64{1, 0, 0, 0}}
+		multiplier = *base
+		overflow   bool
+	)
+	for ; ; e >>= 1 {
+		if e&1 == 1 {
+			if _, overflow = res.MulOverflow(&res, &multiplier); overflow {
+				break
+			}
+		}
+		if e == 1 {
+			break
+		}
+		// every squared multiplier divides the final power, so it must fit as well
+		if _, overflow = multiplier.MulOverflow(&multiplier, &multiplier); overflow {
+			break
+		}
+	}
+	if overflow {
+		return z.Exp(base, exponent), true
+	}
+	return z.Set(&res), false
+}
+
+// ExpMod sets z = base**exponent mod m, and returns z.
+// For moduli m >= 2^192 the Barrett reciprocal of m is computed once and reused
+// for every reduction (see Modulus).
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Uint) ExpMod(base, exponent, m *Uint) *Uint {
+	if m.IsZero() || (m.IsUint64() && m.arr[0] == 1) {
+		return z.Clear()
+	}
+
+	var (
+		md         = NewModulus(m)
+		res        = Uint{arr: [4]uint64{1, 0, 0, 0}}
+		multiplier Uint
+		expBitLen  = exponent.BitLen()
+	)
+	md.Reduce(&multiplier, base)
+
+	for curBit := 0; curBit < expBitLen; curBit++ {
+		if exponent.isBitSet(uint(curBit)) {
+			md.MulMod(&res, &res, &multiplier)
+		}
+		md.MulMod(&multiplier, &multiplier, &multiplier)
+	}
+	return z.Set(&res)
+}
+
+// Sqrt sets z to floor(sqrt(x)), the largest integer such that z*z <= x, and returns z.
+func (z *Uint) Sqrt(x *Uint) *Uint {
+	// This implementation of Sqrt is based on big.Int (see math/big/nat.go).
+	if x.LtUint64(2) {
+		return z.Set(x)
+	}
+	var (
+		z1 = One()
+		z2 = new(Uint)
+	)
+	// Start with value known to be too large and repeat "z = floor((z + floor(x/z))/2)" until it stops getting smaller.
+	z1.Lsh(z1, uint(x.BitLen()+1)/2) // must be >= sqrt(x)
+	for {
+		z2.Div(x, z1)
+		z2.Add(z2, z1)
+		z2.Rsh(z2, 1)
+		if z2.Cmp(z1) >= 0 {
+			// z1 is answer.
+			return z.Set(z1)
+		}
+		z1, z2 = z2, z1
+	}
+}
+
+// CheckedAdd sets z to the sum x+y and returns z.
+// If the sum overflows 256 bits, z is left unchanged and an ErrOverflow error
+// carrying the operands is returned instead.
+func (z *Uint) CheckedAdd(x, y *Uint) (*Uint, error) {
+	var res Uint
+	if _, overflow := res.AddOverflow(x, y); overflow {
+		return nil, errArithOverflow("CheckedAdd", x.Dec(), y.Dec())
+	}
+	return z.Set(&res), nil
+}
+
+// CheckedSub sets z to the difference x-y and returns z.
+// If y > x, z is left unchanged and an ErrOverflow error is returned.
+func (z *Uint) CheckedSub(x, y *Uint) (*Uint, error) {
+	var res Uint
+	if _, overflow := res.SubOverflow(x, y); overflow {
+		return nil, errArithOverflow("CheckedSub", x.Dec(), y.Dec())
+	}
+	return z.Set(&res), nil
+}
+
+// CheckedMul sets z to the product x*y and returns z.
+// If the product overflows 256 bits, z is left unchanged and an ErrOverflow error is returned.
+func (z *Uint) CheckedMul(x, y *Uint) (*Uint, error) {
+	var res Uint
+	if _, overflow := res.MulOverflow(x, y); overflow {
+		return nil, errArithOverflow("CheckedMul", x.Dec(), y.Dec())
+	}
+	return z.Set(&res), nil
+}
+
+// CheckedDiv sets z to the quotient x/y and returns z.
+// Unlike Div, a zero divisor is reported as an ErrDivisionByZero error and z is left unchanged.
+func (z *Uint) CheckedDiv(x, y *Uint) (*Uint, error) {
+	if y.IsZero() {
+		return nil, errDivByZero("CheckedDiv", x.Dec(), y.Dec())
+	}
+	return z.Div(x, y), nil
+}
+
+// CheckedLsh sets z = x << n and returns z.
+// If any set bit of x is shifted out, z is left unchanged and an ErrOverflow error is returned.
+func (z *Uint) CheckedLsh(x *Uint, n uint) (*Uint, error) {
+	if !x.IsZero() && n > 256-uint(x.BitLen()) {
+		return nil, errArithOverflow("CheckedLsh", x.Dec(), strconv.FormatUint(uint64(n), 10))
+	}
+	return z.Lsh(x, n), nil
+}
+
+// CheckedExp sets z = base**exponent and returns z.
+// If the power does not fit in 256 bits, z is left unchanged and an ErrOverflow error is returned.
+func (z *Uint) CheckedExp(base, exponent *Uint) (*Uint, error) {
+	var res Uint
+	if _, overflow := res.ExpOverflow(base, exponent); overflow {
+		return nil, errArithOverflow("CheckedExp", base.Dec(), exponent.Dec())
+	}
+	return z.Set(&res), nil
+}
+
 func (z *Uint) squared() {
 	var (
 		res                    Uint
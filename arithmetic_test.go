@@ -1,6 +1,7 @@
 package uint256
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -247,6 +248,218 @@ func TestMulMod(t *testing.T) {
 	}
 }
 
+func TestSDiv(t *testing.T) {
+	tests := []binOp2Test{
+		{"0x0", "0x0", "0x0"},
+		{"0x1", "0x0", "0x0"},
+		{"0x0", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x0"},
+		{"0x7", "0x2", "0x3"},
+		{"0x7", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd"},                                                                // 7 / -2 = -3
+		{"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff9", "0x2", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd"},                                                                // -7 / 2 = -3
+		{"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff9", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0x3"},                                                                // -7 / -2 = 3
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x8000000000000000000000000000000000000000000000000000000000000000"}, // MinInt256 / -1 = MinInt256
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", "0x1", "0x8000000000000000000000000000000000000000000000000000000000000000"},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", "0x8000000000000000000000000000000000000000000000000000000000000000", "0x1"},
+		{"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x8000000000000000000000000000000000000000000000000000000000000000", "0x0"},
+	}
+
+	for _, tt := range tests {
+		x := MustFromHex(tt.x)
+		y := MustFromHex(tt.y)
+		want := MustFromHex(tt.want)
+
+		got := new(Uint).SDiv(x, y)
+
+		if got.Neq(want) {
+			t.Errorf("SDiv(%s, %s) = %s, want %s", tt.x, tt.y, got.ToString(), want.ToString())
+		}
+	}
+}
+
+func TestSMod(t *testing.T) {
+	tests := []binOp2Test{
+		{"0x0", "0x0", "0x0"},
+		{"0x7", "0x0", "0x0"},
+		{"0x7", "0x3", "0x1"},
+		{"0x7", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd", "0x1"},                                                                                                                               // 7 % -3 = 1
+		{"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff9", "0x3", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},                                                                // -7 % 3 = -1
+		{"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff9", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"}, // -7 % -3 = -1
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x0"},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", "0x3", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"}, // -2^255 % 3 = -2
+	}
+
+	for _, tt := range tests {
+		x := MustFromHex(tt.x)
+		y := MustFromHex(tt.y)
+		want := MustFromHex(tt.want)
+
+		got := new(Uint).SMod(x, y)
+
+		if got.Neq(want) {
+			t.Errorf("SMod(%s, %s) = %s, want %s", tt.x, tt.y, got.ToString(), want.ToString())
+		}
+	}
+}
+
+func TestMulDivOverflow(t *testing.T) {
+	tests := []struct {
+		x, y, d  string
+		want     string
+		overflow bool
+	}{
+		{"0x0", "0x31337", "0x3", "0x0", false},
+		{"0x31337", "0x3", "0x0", "0x0", false},
+		{"0x64", "0x3", "0x7", "0x2a", false},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x2", "0x2", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", false},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", false},
+		{"0x800000000000000000000000000000000000000000000000", "0x1000000000000000000000000000000000000000000000000", "0x100000000000000000000000000000000", "0x8000000000000000000000000000000000000000000000000000000000000000", false}, // 2^191 * 2^192 / 2^128 = 2^255
+		{"0x1000000000000000000000000000000000000000000000000", "0x1000000000000000000000000000000000000000000000000", "0x100000000000000000000000000000000", "0x0", true},                                                                // 2^192 * 2^192 / 2^128 = 2^256
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x3", "0x2", "0x7ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", true},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x1", "0x1", true},
+		{"0x1000000000000000000000000", "0xde0b6b3a7640000", "0x3", "0x4a03ce68d215555555555555555555555555555", false},
+	}
+
+	for _, tt := range tests {
+		x := MustFromHex(tt.x)
+		y := MustFromHex(tt.y)
+		d := MustFromHex(tt.d)
+		want := MustFromHex(tt.want)
+
+		got, overflow := new(Uint).MulDivOverflow(x, y, d)
+
+		if got.Neq(want) || overflow != tt.overflow {
+			t.Errorf("MulDivOverflow(%s, %s, %s) = (%s, %v), want (%s, %v)",
+				tt.x, tt.y, tt.d, got.ToString(), overflow, want.ToString(), tt.overflow)
+		}
+
+		got = new(Uint).MulDiv(x, y, d)
+		if got.Neq(want) {
+			t.Errorf("MulDiv(%s, %s, %s) = %s, want %s", tt.x, tt.y, tt.d, got.ToString(), want.ToString())
+		}
+	}
+}
+
+func TestDivRound(t *testing.T) {
+	tests := []struct {
+		x, y string
+		mode RoundingMode
+		want string
+	}{
+		{"31337", "0", Ceil, "0"},
+		{"0", "3", Ceil, "0"},
+		{"9", "3", Ceil, "3"},
+		{"10", "3", Floor, "3"},
+		{"10", "3", Ceil, "4"},
+		{"10", "3", HalfUp, "3"},
+		{"11", "3", HalfUp, "4"},
+		{"5", "2", HalfUp, "3"},
+		{"5", "2", HalfEven, "2"},
+		{"7", "2", HalfEven, "4"},
+		{"7", "2", Floor, "3"},
+		{twoPow256Sub1, "2", Ceil, "57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{twoPow256Sub1, twoPow256Sub1, Ceil, "1"},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639934", twoPow256Sub1, HalfEven, "1"},
+	}
+
+	for _, tt := range tests {
+		x := MustFromDecimal(tt.x)
+		y := MustFromDecimal(tt.y)
+		want := MustFromDecimal(tt.want)
+
+		got := new(Uint).DivRound(x, y, tt.mode)
+
+		if got.Neq(want) {
+			t.Errorf("DivRound(%s, %s, %d) = %s, want %s", tt.x, tt.y, tt.mode, got.ToString(), want.ToString())
+		}
+		if tt.mode == Ceil {
+			if got := new(Uint).DivRoundUp(x, y); got.Neq(want) {
+				t.Errorf("DivRoundUp(%s, %s) = %s, want %s", tt.x, tt.y, got.ToString(), want.ToString())
+			}
+		}
+	}
+}
+
+func TestMulDivRoundOverflow(t *testing.T) {
+	tests := []struct {
+		x, y, d  string
+		mode     RoundingMode
+		want     string
+		overflow bool
+	}{
+		{"0x0", "0x5", "0x3", Ceil, "0x0", false},
+		{"0x5", "0x5", "0x0", Ceil, "0x0", false},
+		{"0x5", "0x2", "0x3", Floor, "0x3", false},
+		{"0x5", "0x2", "0x3", Ceil, "0x4", false},
+		{"0x5", "0x2", "0x3", HalfUp, "0x3", false},
+		{"0x5", "0x1", "0x2", HalfUp, "0x3", false},
+		{"0x5", "0x1", "0x2", HalfEven, "0x2", false},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", Floor, "0x0", true},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", Ceil, "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", false},
+		{"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0x8000000000000000000000000000000000000000000000000000000000000001", "0x8000000000000000000000000000000000000000000000000000000000000000", Floor, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", false},
+		{"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0x8000000000000000000000000000000000000000000000000000000000000001", "0x8000000000000000000000000000000000000000000000000000000000000000", Ceil, "0x0", true},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x3", "0x3", Ceil, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", false},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", Ceil, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", false},
+	}
+
+	for _, tt := range tests {
+		x := MustFromHex(tt.x)
+		y := MustFromHex(tt.y)
+		d := MustFromHex(tt.d)
+		want := MustFromHex(tt.want)
+
+		got, overflow := new(Uint).MulDivRoundOverflow(x, y, d, tt.mode)
+
+		if got.Neq(want) || overflow != tt.overflow {
+			t.Errorf("MulDivRoundOverflow(%s, %s, %s, %d) = (%s, %v), want (%s, %v)",
+				tt.x, tt.y, tt.d, tt.mode, got.ToString(), overflow, want.ToString(), tt.overflow)
+		}
+		if tt.mode == Ceil {
+			if got := new(Uint).MulDivRoundingUp(x, y, d); got.Neq(want) {
+				t.Errorf("MulDivRoundingUp(%s, %s, %s) = %s, want %s", tt.x, tt.y, tt.d, got.ToString(), want.ToString())
+			}
+		}
+	}
+}
+
+func TestAddMod(t *testing.T) {
+	tests := []struct {
+		x    string
+		y    string
+		m    string
+		want string
+	}{
+		{"0x1", "0x2", "0x0", "0x0"},
+		{"0x5", "0x6", "0x7", "0x4"},
+		{"0x3", "0x4", "0x1", "0x0"},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x0"},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x1", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", "0x1000003d1"},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", "0x2000007a0"},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x10000000000000000", "0xfffffffffffffffe"},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x1000000000000000000000000000000000000000000000007", "0xfffffffffffffffffffffffffffffff20000000000000005"},
+		{"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2d"},
+	}
+
+	for _, tt := range tests {
+		x := MustFromHex(tt.x)
+		y := MustFromHex(tt.y)
+		m := MustFromHex(tt.m)
+		want := MustFromHex(tt.want)
+
+		got := new(Uint).AddMod(x, y, m)
+
+		if got.Neq(want) {
+			t.Errorf("AddMod(%s, %s, %s) = %s, want %s", tt.x, tt.y, tt.m, got.ToString(), want.ToString())
+		}
+
+		// z aliasing the modulus
+		got = m.Clone()
+		got.AddMod(x, y, got)
+		if got.Neq(want) {
+			t.Errorf("AddMod(%s, %s, %s) with z == m = %s, want %s", tt.x, tt.y, tt.m, got.ToString(), want.ToString())
+		}
+	}
+}
+
 func TestDivMod(t *testing.T) {
 	tests := []struct {
 		x       string
@@ -374,6 +587,232 @@ func TestExp_LargeExponent(t *testing.T) {
 	}
 }
 
+func TestSaturating(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(z, x, y *Uint) *Uint
+		x, y string
+		want string
+	}{
+		{"SaturatingAdd", (*Uint).SaturatingAdd, "1", "2", "3"},
+		{"SaturatingAdd", (*Uint).SaturatingAdd, twoPow256Sub1, "1", twoPow256Sub1},
+		{"SaturatingAdd", (*Uint).SaturatingAdd, twoPow256Sub1, twoPow256Sub1, twoPow256Sub1},
+		{"SaturatingSub", (*Uint).SaturatingSub, "3", "2", "1"},
+		{"SaturatingSub", (*Uint).SaturatingSub, "2", "3", "0"},
+		{"SaturatingSub", (*Uint).SaturatingSub, "0", twoPow256Sub1, "0"},
+		{"SaturatingMul", (*Uint).SaturatingMul, "18446744073709551616", "18446744073709551616", "340282366920938463463374607431768211456"},
+		{"SaturatingMul", (*Uint).SaturatingMul, twoPow256Sub1, "2", twoPow256Sub1},
+		{"SaturatingMul", (*Uint).SaturatingMul, twoPow256Sub1, "0", "0"},
+	}
+
+	for _, tt := range tests {
+		x := MustFromDecimal(tt.x)
+		y := MustFromDecimal(tt.y)
+
+		got := tt.fn(new(Uint), x, y)
+
+		if got.Dec() != tt.want {
+			t.Errorf("%s(%s, %s) = %s, want %s", tt.name, tt.x, tt.y, got.Dec(), tt.want)
+		}
+	}
+}
+
+func TestExpOverflow(t *testing.T) {
+	tests := []struct {
+		base, exp string
+		want      string
+		overflow  bool
+	}{
+		{"0", "0", "1", false},
+		{"0", twoPow256Sub1, "0", false},
+		{"1", twoPow256Sub1, "1", false},
+		{twoPow256Sub1, "1", twoPow256Sub1, false},
+		{"2", "255", "57896044618658097711785492504343953926634992332820282019728792003956564819968", false},
+		{"2", "256", "0", true},
+		{"2", "18446744073709551616", "0", true},
+		{"10", "18", "1000000000000000000", false},
+		{"10", "77", "100000000000000000000000000000000000000000000000000000000000000000000000000000", false},
+		{"10", "78", "", true},
+		{"3", "161", "65542350158517637872691969508970705427701150314738255642438471845988797065603", false},
+		{"3", "162", "", true},
+		{"7", "91", "80153343160247310515380886994816022539378033762994852007501964604841680190743", false},
+		{"7", "92", "", true},
+		{"340282366920938463463374607431768211455", "2", "115792089237316195423570985008687907852589419931798687112530834793049593217025", false},
+		{"340282366920938463463374607431768211456", "2", "0", true},
+	}
+
+	for _, tt := range tests {
+		base := MustFromDecimal(tt.base)
+		exp := MustFromDecimal(tt.exp)
+
+		got, overflow := new(Uint).ExpOverflow(base, exp)
+
+		// on overflow the result must still match the wrapping Exp
+		want := tt.want
+		if want == "" {
+			want = new(Uint).Exp(base, exp).Dec()
+		}
+		if got.Dec() != want || overflow != tt.overflow {
+			t.Errorf("ExpOverflow(%s, %s) = (%s, %v), want (%s, %v)", tt.base, tt.exp, got.Dec(), overflow, want, tt.overflow)
+		}
+	}
+}
+
+func TestExpMod(t *testing.T) {
+	tests := []struct {
+		base     string
+		exponent string
+		m        string
+		want     string
+	}{
+		{"0x2", "0xa", "0x3e8", "0x18"},
+		{"0x3", "0x0", "0x7", "0x1"},
+		{"0x0", "0x0", "0x7", "0x1"},
+		{"0x5", "0x3", "0x1", "0x0"},
+		{"0x5", "0x3", "0x0", "0x0"},
+		{"0x3039", "0x10932", "0xffffffffffffffc5", "0x7097dde94e48f92"},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x2", "0x100000000000000000000000000000001", "0x0"},
+		{"0x2", "0xff", "0x1000000000000000000000000000000000000000000000000", "0x0"},
+		{"0x7", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xc63680d06731aaa6c9fd04f7d503763bc4a172f76e5d5a52e1c0581e0df59", "0x9fb890edb434c1888f833352f5aa1212afe34a5656cdd660aa4d703d50613"},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x0"},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", "0xad0a8c73022bdaa5b4e042c6846d1c3811c064b799b934145bf4ab20aa5c5fc8"},
+		{"0x2", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2d", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffff7ffffe18"}, // 2^(p-2) = 2^-1 mod p
+		{"0x3", "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036413f", "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa9d1c9e899ca306ad27fe1945de0242b81"},
+	}
+
+	for _, tt := range tests {
+		base := MustFromHex(tt.base)
+		exponent := MustFromHex(tt.exponent)
+		m := MustFromHex(tt.m)
+		want := MustFromHex(tt.want)
+
+		got := new(Uint).ExpMod(base, exponent, m)
+
+		if got.Neq(want) {
+			t.Errorf("ExpMod(%s, %s, %s) = %s, want %s", tt.base, tt.exponent, tt.m, got.ToString(), want.ToString())
+		}
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0", "0"},
+		{"1", "1"},
+		{"2", "1"},
+		{"3", "1"},
+		{"4", "2"},
+		{"15", "3"},
+		{"16", "4"},
+		{"18446744073709551615", "4294967295"},
+		{"18446744073709551616", "4294967296"},
+		{"340282366920938463463374607431768211455", "18446744073709551615"},
+		{"79228162514264337593543950336", "281474976710656"}, // sqrt(2^96) = 2^48
+		{twoPow256Sub1, "340282366920938463463374607431768211455"},
+	}
+
+	for _, tt := range tests {
+		x := MustFromDecimal(tt.x)
+		want := MustFromDecimal(tt.want)
+
+		got := new(Uint).Sqrt(x)
+
+		if got.Neq(want) {
+			t.Errorf("Sqrt(%s) = %s, want %s", tt.x, got.ToString(), want.ToString())
+		}
+	}
+}
+
+func TestCheckedArithmetic(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func(z, x, y *Uint) (*Uint, error)
+		x, y    string
+		want    string
+		wantErr error
+	}{
+		{"CheckedAdd", (*Uint).CheckedAdd, "1", "2", "3", nil},
+		{"CheckedAdd", (*Uint).CheckedAdd, twoPow256Sub1, "1", "", ErrOverflow},
+		{"CheckedSub", (*Uint).CheckedSub, "3", "2", "1", nil},
+		{"CheckedSub", (*Uint).CheckedSub, "2", "3", "", ErrOverflow},
+		{"CheckedMul", (*Uint).CheckedMul, "340282366920938463463374607431768211455", "340282366920938463463374607431768211457", twoPow256Sub1, nil},
+		{"CheckedMul", (*Uint).CheckedMul, "340282366920938463463374607431768211456", "340282366920938463463374607431768211456", "", ErrOverflow},
+		{"CheckedDiv", (*Uint).CheckedDiv, "10", "3", "3", nil},
+		{"CheckedDiv", (*Uint).CheckedDiv, "10", "0", "", ErrDivisionByZero},
+		{"CheckedExp", (*Uint).CheckedExp, "2", "255", "57896044618658097711785492504343953926634992332820282019728792003956564819968", nil},
+		{"CheckedExp", (*Uint).CheckedExp, "2", "256", "", ErrOverflow},
+		{"CheckedExp", (*Uint).CheckedExp, "10", "77", "100000000000000000000000000000000000000000000000000000000000000000000000000000", nil},
+		{"CheckedExp", (*Uint).CheckedExp, "10", "78", "", ErrOverflow},
+		{"CheckedExp", (*Uint).CheckedExp, "3", "162", "", ErrOverflow},
+		{"CheckedExp", (*Uint).CheckedExp, "1", twoPow256Sub1, "1", nil},
+		{"CheckedExp", (*Uint).CheckedExp, "0", twoPow256Sub1, "0", nil},
+		{"CheckedExp", (*Uint).CheckedExp, twoPow256Sub1, "0", "1", nil},
+		{"CheckedExp", (*Uint).CheckedExp, "340282366920938463463374607431768211456", "2", "", ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		x := MustFromDecimal(tt.x)
+		y := MustFromDecimal(tt.y)
+		z := NewUint(42)
+
+		got, err := tt.fn(z, x, y)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s(%s, %s) error = %v, want %v", tt.name, tt.x, tt.y, err, tt.wantErr)
+			}
+			if got != nil || !z.Eq(NewUint(42)) {
+				t.Errorf("%s(%s, %s) modified z on error", tt.name, tt.x, tt.y)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s(%s, %s) unexpected error: %v", tt.name, tt.x, tt.y, err)
+			continue
+		}
+		if got.Dec() != tt.want {
+			t.Errorf("%s(%s, %s) = %s, want %s", tt.name, tt.x, tt.y, got.Dec(), tt.want)
+		}
+	}
+
+	_, err := new(Uint).CheckedAdd(MustFromDecimal(twoPow256Sub1), NewUint(1))
+	if want := "CheckedAdd: " + twoPow256Sub1 + ", 1: arithmetic overflow"; err.Error() != want {
+		t.Errorf("error message = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestCheckedLsh(t *testing.T) {
+	tests := []struct {
+		x       string
+		n       uint
+		want    string
+		wantErr bool
+	}{
+		{"0", 1000, "0", false},
+		{"1", 255, "57896044618658097711785492504343953926634992332820282019728792003956564819968", false},
+		{"1", 256, "", true},
+		{"3", 255, "", true},
+		{twoPow256Sub1, 0, twoPow256Sub1, false},
+		{twoPow256Sub1, 1, "", true},
+		{"1", ^uint(0), "", true},
+		{"1", ^uint(0) - 100, "", true},
+		{"0", ^uint(0), "0", false},
+	}
+
+	for _, tt := range tests {
+		got, err := new(Uint).CheckedLsh(MustFromDecimal(tt.x), tt.n)
+		if tt.wantErr {
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("CheckedLsh(%s, %d) error = %v, want %v", tt.x, tt.n, err, ErrOverflow)
+			}
+			continue
+		}
+		if err != nil || got.Dec() != tt.want {
+			t.Errorf("CheckedLsh(%s, %d) = (%v, %v), want %s", tt.x, tt.n, got, err, tt.want)
+		}
+	}
+}
+
 var (
 	x, y, z *Uint
 	m       *Uint
@@ -457,3 +896,81 @@ func BenchmarkExp(b *testing.B) {
 		z.Exp(x, y)
 	}
 }
+
+func BenchmarkMulMod_Secp256k1(b *testing.B) {
+	p := MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+	x := MustFromHex("0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	y := MustFromHex("0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	var z Uint
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		z.MulMod(x, y, p)
+	}
+}
+
+func BenchmarkModulusMulMod_Secp256k1(b *testing.B) {
+	md := NewModulus(MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"))
+	x := MustFromHex("0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	y := MustFromHex("0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	var z Uint
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		md.MulMod(&z, x, y)
+	}
+}
+
+func BenchmarkMontMul_Secp256k1(b *testing.B) {
+	ctx, _ := NewMontgomeryCtx(MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"))
+	var x, y, z Uint
+	ctx.ToMont(&x, MustFromHex("0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))
+	ctx.ToMont(&y, MustFromHex("0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx.MontMul(&z, &x, &y)
+	}
+}
+
+func BenchmarkExpMod_Secp256k1(b *testing.B) {
+	p := MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+	x := MustFromHex("0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	e := MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2d")
+	var z Uint
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		z.ExpMod(x, e, p)
+	}
+}
+
+func BenchmarkMontExp_Secp256k1(b *testing.B) {
+	ctx, _ := NewMontgomeryCtx(MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"))
+	e := MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2d")
+	var x, z Uint
+	ctx.ToMont(&x, MustFromHex("0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx.MontExp(&z, &x, e)
+	}
+}
+
+func BenchmarkAddMod(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		z.AddMod(x, y, m)
+	}
+}
+
+func BenchmarkExpMod(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		z.ExpMod(x, y, m)
+	}
+}
+
+func BenchmarkSqrt(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		z.Sqrt(x)
+	}
+}
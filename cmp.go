@@ -123,3 +123,19 @@ func (z *Uint) Sgt(x *Uint) bool {
 		return z.Gt(x)
 	}
 }
+
+// Slt interprets z and x as signed integers, and returns
+// true if z < x
+func (z *Uint) Slt(x *Uint) bool {
+	zSign := z.Sign()
+	xSign := x.Sign()
+
+	switch {
+	case zSign >= 0 && xSign < 0:
+		return false
+	case zSign < 0 && xSign >= 0:
+		return true
+	default:
+		return z.Lt(x)
+	}
+}
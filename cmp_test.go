@@ -160,6 +160,31 @@ func TestSGT(t *testing.T) {
 	}
 }
 
+func TestSLT(t *testing.T) {
+	tests := []struct {
+		x, y string
+		want bool
+	}{
+		{"0x0", "0x0", false},
+		{"0x1", "0x2", true},
+		{"0x2", "0x1", false},
+		{"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0x0", true},
+		{"0x0", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", false},
+		{"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", true},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", true},
+		{"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0x8000000000000000000000000000000000000000000000000000000000000000", false},
+	}
+
+	for _, tt := range tests {
+		x := MustFromHex(tt.x)
+		y := MustFromHex(tt.y)
+
+		if got := x.Slt(y); got != tt.want {
+			t.Errorf("Slt(%s, %s) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
 func TestEq(t *testing.T) {
 	tests := []struct {
 		x    string
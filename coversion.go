@@ -6,6 +6,9 @@ package uint256
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
 	"strconv"
 	"strings"
 )
@@ -118,10 +121,9 @@ func (z *Uint) scanScientificFromString(src string) error {
 	if err := exp.SetFromDecimal(src[(idx + 1):]); err != nil {
 		return err
 	}
-	if exp.GtUint64(77) { // 10**78 is larger than 2**256
+	if _, overflow := exp.ExpOverflow(NewUint(10), exp); overflow {
 		return ErrBig256Range
 	}
-	exp.Exp(NewUint(10), exp)
 	if _, overflow := z.MulOverflow(z, exp); overflow {
 		return ErrBig256Range
 	}
@@ -138,6 +140,298 @@ func (z *Uint) ToString() string {
 	return z.Dec()
 }
 
+// SetString sets z to the value of s, interpreted in the given base,
+// and returns z and a boolean indicating success. If SetString fails,
+// the value of z is undefined but the returned value is nil.
+//
+// The semantics follow big.Int.SetString: the base argument must be 0 or
+// a value between 2 and 36. For base 0, the number prefix determines the
+// actual base: a prefix of "0b" or "0B" selects base 2, "0", "0o" or "0O"
+// selects base 8, and "0x" or "0X" selects base 16. Otherwise, the selected
+// base is 10 and no prefix is accepted. For base 0 only, underscores may
+// appear between digits or between a base prefix and a digit.
+// An optional "+" sign is accepted; negative numbers are not.
+// Numbers larger than 256 bits are not accepted.
+func (z *Uint) SetString(s string, base int) (*Uint, bool) {
+	if len(s) > 0 && s[0] == '+' {
+		s = s[1:]
+	}
+	if err := z.setString(s, base); err != nil {
+		return nil, false
+	}
+	return z, true
+}
+
+// setString is the error-reporting implementation of SetString. s must not carry a sign.
+func (z *Uint) setString(s string, base int) error {
+	const fn = "SetString"
+
+	if s == "" {
+		return errSyntax(fn, s)
+	}
+
+	var (
+		s0       = s
+		base0    = base == 0
+		octalPfx = false // a bare "0" prefix is also a digit, so "0" alone is valid
+	)
+	switch {
+	case 2 <= base && base <= 36:
+		// valid base; nothing to do
+
+	case base == 0:
+		// Look for octal, hex prefix.
+		base = 10
+		if s[0] == '0' {
+			switch {
+			case len(s) >= 2 && lower(s[1]) == 'b':
+				base = 2
+				s = s[2:]
+			case len(s) >= 2 && lower(s[1]) == 'o':
+				base = 8
+				s = s[2:]
+			case len(s) >= 2 && lower(s[1]) == 'x':
+				base = 16
+				s = s[2:]
+			default:
+				base = 8
+				s = s[1:]
+				octalPfx = true
+			}
+		}
+		if !underscoreOK(s0) {
+			return errSyntax(fn, s0)
+		}
+
+	default:
+		return errInvalidBase(fn, base)
+	}
+
+	// Digits are collected into a uint64 chunk for as long as chunk*base fits,
+	// and only then folded into z, so most digits cost a single word multiplication.
+	var (
+		chunk   uint64
+		mult    uint64 = 1
+		maxMult        = MaxUint64 / uint64(base)
+		digits  bool
+	)
+	z.Clear()
+	for _, c := range []byte(s) {
+		var d byte
+		switch {
+		case c == '_' && base0:
+			continue
+		case '0' <= c && c <= '9':
+			d = c - '0'
+		case 'a' <= lower(c) && lower(c) <= 'z':
+			d = lower(c) - 'a' + 10
+		default:
+			return errSyntax(fn, s0)
+		}
+		if d >= byte(base) {
+			return errSyntax(fn, s0)
+		}
+
+		if mult > maxMult {
+			if z.mulAddWord(mult, chunk) {
+				return errBig256Range(fn, s0)
+			}
+			chunk, mult = 0, 1
+		}
+		chunk = chunk*uint64(base) + uint64(d)
+		mult *= uint64(base)
+		digits = true
+	}
+	if !digits && !octalPfx {
+		return errSyntax(fn, s0)
+	}
+	if z.mulAddWord(mult, chunk) {
+		return errBig256Range(fn, s0)
+	}
+	return nil
+}
+
+// mulAddWord sets z = z*m + a, and returns whether overflow occurred.
+func (z *Uint) mulAddWord(m, a uint64) bool {
+	var carry uint64
+	carry, z.arr[0] = umulHop(a, z.arr[0], m)
+	carry, z.arr[1] = umulHop(carry, z.arr[1], m)
+	carry, z.arr[2] = umulHop(carry, z.arr[2], m)
+	carry, z.arr[3] = umulHop(carry, z.arr[3], m)
+	return carry != 0
+}
+
+// Text returns the string representation of z in the given base.
+// Base must be between 2 and 36, inclusive. The result uses the
+// lower-case letters 'a' to 'z' for digit values 10 to 35, and no
+// base prefix is added (same as big.Int.Text).
+// It returns "<nil>" if z is nil and panics on an invalid base.
+func (z *Uint) Text(base int) string {
+	if z == nil {
+		return "<nil>"
+	}
+	if base < 2 || base > 36 {
+		panic("u256: invalid base " + strconv.Itoa(base))
+	}
+	if base == 10 {
+		return z.Dec()
+	}
+	if z.IsUint64() {
+		return strconv.FormatUint(z.Uint64(), base)
+	}
+
+	// Like Dec, peel off chunks of digits by dividing by the largest power
+	// of base that fits in a uint64.
+	var (
+		chunk  = uint64(base)
+		digits = 1
+	)
+	for chunk <= MaxUint64/uint64(base) {
+		chunk *= uint64(base)
+		digits++
+	}
+	var (
+		out     [256]byte // base 2 needs up to 256 digits
+		pos     = len(out)
+		divisor = NewUint(chunk)
+		y       = *z // copy to avoid modifying z
+		buf     = make([]byte, 0, 64)
+	)
+	for {
+		var quot Uint
+		rem := udivrem(quot.arr[:], y.arr[:], divisor)
+		y = quot
+		buf = strconv.AppendUint(buf[:0], rem.Uint64(), base)
+		copy(out[pos-len(buf):], buf)
+		if y.IsZero() {
+			pos -= len(buf)
+			break
+		}
+		// inner chunks are zero-padded to their full width
+		for i := pos - digits; i < pos-len(buf); i++ {
+			out[i] = '0'
+		}
+		pos -= digits
+	}
+	return string(out[pos:])
+}
+
+// String returns the decimal representation of z, or "<nil>" if z is nil.
+// It implements fmt.Stringer.
+func (z *Uint) String() string {
+	if z == nil {
+		return "<nil>"
+	}
+	return z.Dec()
+}
+
+// Format implements fmt.Formatter with the verbs and flags of big.Int.Format:
+// 'b', 'o', 'O', 'd', 'x', 'X' (plus 's' and 'v' as decimal), sign control,
+// '#' prefixes, precision, field width and padding.
+// Formatting goes through ToBig, so the output is identical to big.Int's.
+func (z *Uint) Format(s fmt.State, ch rune) {
+	switch ch {
+	case 'b', 'o', 'O', 'd', 's', 'v', 'x', 'X':
+	default:
+		// unknown format
+		fmt.Fprintf(s, "%%!%c(uint256.Uint=%s)", ch, z.String())
+		return
+	}
+	if z == nil {
+		fmt.Fprint(s, "<nil>")
+		return
+	}
+	z.ToBig().Format(s, ch)
+}
+
+// maxWords is the number of big.Words needed to hold a 256-bit value.
+const maxWords = 256 / bits.UintSize
+
+// ToBig returns a big.Int version of z.
+// Return `nil` if z is nil
+func (z *Uint) ToBig() *big.Int {
+	if z == nil {
+		return nil
+	}
+	b := new(big.Int)
+	switch maxWords { // Compile-time check.
+	case 4: // 64-bit architectures.
+		words := [4]big.Word{big.Word(z.arr[0]), big.Word(z.arr[1]), big.Word(z.arr[2]), big.Word(z.arr[3])}
+		b.SetBits(words[:])
+	case 8: // 32-bit architectures.
+		words := [8]big.Word{
+			big.Word(z.arr[0]), big.Word(z.arr[0] >> 32),
+			big.Word(z.arr[1]), big.Word(z.arr[1] >> 32),
+			big.Word(z.arr[2]), big.Word(z.arr[2] >> 32),
+			big.Word(z.arr[3]), big.Word(z.arr[3] >> 32),
+		}
+		b.SetBits(words[:])
+	}
+	return b
+}
+
+// FromBig is a convenience-constructor from big.Int.
+// Returns a new Uint and whether overflow occurred.
+// OBS: If b is negative, the result is the two's complement of |b| and overflow is reported
+// (differs from holiman's uint256, which reports negative values as not overflowing).
+func FromBig(b *big.Int) (*Uint, bool) {
+	z := &Uint{}
+	overflow := z.SetFromBig(b)
+	return z, overflow
+}
+
+// MustFromBig is a convenience-constructor from big.Int.
+// Returns a new Uint and panics if overflow occurred.
+func MustFromBig(b *big.Int) *Uint {
+	z := &Uint{}
+	if z.SetFromBig(b) {
+		panic("overflow")
+	}
+	return z
+}
+
+// SetFromBig sets z to b mod 2^256, and returns whether overflow occurred,
+// i.e. whether b is negative or does not fit in 256 bits.
+func (z *Uint) SetFromBig(b *big.Int) bool {
+	z.Clear()
+	words := b.Bits()
+	overflow := len(words) > maxWords
+
+	switch maxWords { // Compile-time check.
+	case 4: // 64-bit architectures.
+		if len(words) > 0 {
+			z.arr[0] = uint64(words[0])
+			if len(words) > 1 {
+				z.arr[1] = uint64(words[1])
+				if len(words) > 2 {
+					z.arr[2] = uint64(words[2])
+					if len(words) > 3 {
+						z.arr[3] = uint64(words[3])
+					}
+				}
+			}
+		}
+	case 8: // 32-bit architectures.
+		numWords := len(words)
+		if overflow {
+			numWords = maxWords
+		}
+		for i := 0; i < numWords; i++ {
+			if i%2 == 0 {
+				z.arr[i/2] = uint64(words[i])
+			} else {
+				z.arr[i/2] |= uint64(words[i]) << 32
+			}
+		}
+	}
+
+	if b.Sign() == -1 {
+		z.Neg(z)
+		overflow = true
+	}
+	return overflow
+}
+
 // MarshalJSON implements json.Marshaler.
 // MarshalJSON marshals using the 'decimal string' representation. This is _not_ compatible
 // with big.Uint: big.Uint marshals into JSON 'native' numeric format.
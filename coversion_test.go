@@ -1,6 +1,11 @@
 package uint256
 
-import "testing"
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+)
 
 func TestIsUint64(t *testing.T) {
 	tests := []struct {
@@ -173,3 +178,176 @@ func TestSetBytes(t *testing.T) {
 		}
 	}
 }
+
+func TestFromBig(t *testing.T) {
+	tests := []struct {
+		x        string
+		want     string
+		overflow bool
+	}{
+		{"0", "0", false},
+		{"1", "1", false},
+		{"18446744073709551616", "18446744073709551616", false},
+		{twoPow256Sub1, twoPow256Sub1, false},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639936", "0", true}, // 2^256
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639937", "1", true}, // 2^256 + 1
+		{"-1", twoPow256Sub1, true},
+		{"-18446744073709551616", "115792089237316195423570985008687907853269984665640564039439137263839420088320", true},
+	}
+
+	for _, tt := range tests {
+		b, _ := new(big.Int).SetString(tt.x, 10)
+
+		got, overflow := FromBig(b)
+
+		if got.Dec() != tt.want || overflow != tt.overflow {
+			t.Errorf("FromBig(%s) = (%s, %v), want (%s, %v)", tt.x, got.Dec(), overflow, tt.want, tt.overflow)
+		}
+		if !tt.overflow {
+			if back := got.ToBig(); back.Cmp(b) != 0 {
+				t.Errorf("FromBig(%s).ToBig() = %s", tt.x, back)
+			}
+			if must := MustFromBig(b); must.Neq(got) {
+				t.Errorf("MustFromBig(%s) = %s, want %s", tt.x, must.Dec(), got.Dec())
+			}
+		}
+	}
+
+	if b := (*Uint)(nil).ToBig(); b != nil {
+		t.Errorf("nil.ToBig() = %v, want nil", b)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("MustFromBig(-1) did not panic")
+		}
+	}()
+	MustFromBig(big.NewInt(-1))
+}
+
+func TestTextSetString(t *testing.T) {
+	values := []string{
+		"0",
+		"1",
+		"35",
+		"18446744073709551615",
+		"18446744073709551616",
+		"340282366920938463463374607431768211455",
+		"12345678901234567890123456789012345678901234567890",
+		"57896044618658097711785492504343953926634992332820282019728792003956564819968",
+		twoPow256Sub1,
+	}
+
+	for _, v := range values {
+		x := MustFromDecimal(v)
+		b, _ := new(big.Int).SetString(v, 10)
+
+		for base := 2; base <= 36; base++ {
+			want := b.Text(base)
+			if got := x.Text(base); got != want {
+				t.Errorf("Text(%s, %d) = %s, want %s", v, base, got, want)
+			}
+
+			got, ok := new(Uint).SetString(want, base)
+			if !ok || got.Neq(x) {
+				t.Errorf("SetString(%s, %d) = (%v, %v), want %s", want, base, got, ok, v)
+			}
+		}
+	}
+
+	if got := (*Uint)(nil).Text(16); got != "<nil>" {
+		t.Errorf("nil.Text(16) = %s, want <nil>", got)
+	}
+}
+
+func TestSetString(t *testing.T) {
+	tests := []struct {
+		s    string
+		base int
+		want string
+		ok   bool
+	}{
+		{"0", 0, "0", true},
+		{"+42", 0, "42", true},
+		{"0b101", 0, "5", true},
+		{"0B101", 0, "5", true},
+		{"0o17", 0, "15", true},
+		{"017", 0, "15", true},
+		{"0x1F", 0, "31", true},
+		{"0x_1f", 0, "31", true},
+		{"1_000_000", 0, "1000000", true},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 0, twoPow256Sub1, true},
+		{"0x0000000000000000000000000000000000000000000000000000000000000000001", 0, "1", true},
+		{"ff", 16, "255", true},
+		{"FF", 16, "255", true},
+		{"zz", 36, "1295", true},
+		{"", 0, "", false},
+		{"+", 0, "", false},
+		{"-1", 0, "", false},
+		{"0x", 0, "", false},
+		{"0b", 0, "", false},
+		{"0b2", 0, "", false},
+		{"08", 0, "", false},
+		{"0x10", 16, "", false},
+		{"1_000", 10, "", false},
+		{"1__000", 0, "", false},
+		{"_1000", 0, "", false},
+		{"1000_", 0, "", false},
+		{"12", 2, "", false},
+		{"1", 1, "", false},
+		{"1", 37, "", false},
+		{"0x10000000000000000000000000000000000000000000000000000000000000000", 0, "", false},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639936", 10, "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := new(Uint).SetString(tt.s, tt.base)
+		if ok != tt.ok {
+			t.Errorf("SetString(%q, %d) ok = %v, want %v", tt.s, tt.base, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			if got != nil {
+				t.Errorf("SetString(%q, %d) = %v, want nil", tt.s, tt.base, got)
+			}
+			continue
+		}
+		if got.Dec() != tt.want {
+			t.Errorf("SetString(%q, %d) = %s, want %s", tt.s, tt.base, got.Dec(), tt.want)
+		}
+
+		// big.Int agrees on every accepted input
+		b, bok := new(big.Int).SetString(tt.s, tt.base)
+		if !bok || b.String() != tt.want {
+			t.Errorf("big.Int.SetString(%q, %d) = (%v, %v), want %s", tt.s, tt.base, b, bok, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	formats := []string{
+		"%d", "%x", "%X", "%#x", "%#X", "%o", "%#o", "%O", "%b", "%#b", "%v", "%s",
+		"%+d", "% d", "%10d", "%-10d|", "%010d", "%.5d", "%.0d", "%8.5x", "%#010x", "%+#x", "%q",
+	}
+	values := []string{"0", "1", "255", "18446744073709551616", twoPow256Sub1}
+
+	for _, v := range values {
+		x := MustFromDecimal(v)
+		b, _ := new(big.Int).SetString(v, 10)
+
+		for _, f := range formats {
+			want := strings.Replace(fmt.Sprintf(f, b), "big.Int", "uint256.Uint", 1)
+			if got := fmt.Sprintf(f, x); got != want {
+				t.Errorf("Sprintf(%q, %s) = %q, want %q", f, v, got, want)
+			}
+		}
+	}
+
+	var nilUint *Uint
+	if got := fmt.Sprintf("%d|%v|%s", nilUint, nilUint, nilUint.String()); got != "<nil>|<nil>|<nil>" {
+		t.Errorf("Sprintf(nil) = %q", got)
+	}
+	if got := fmt.Sprint(NewUint(42)); got != "42" {
+		t.Errorf("Sprint(42) = %q, want 42", got)
+	}
+}
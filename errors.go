@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"strconv"
+	"strings"
 )
 
 var (
@@ -18,6 +19,12 @@ var (
 	ErrBadEncodedLength = errors.New("bad ssz encoded length")
 	ErrInvalidBase      = errors.New("invalid base")
 	ErrInvalidBitSize   = errors.New("invalid bit size")
+	ErrEvenModulus      = errors.New("modulus must be odd")
+	ErrUint128Range     = errors.New("number out of uint128 range")
+	ErrOverflow         = errors.New("arithmetic overflow")
+	ErrDivisionByZero   = errors.New("division by zero")
+	ErrEmptyInput       = errors.New("empty number string")
+	ErrInvalidNumber    = errors.New("invalid number string")
 )
 
 type u256Error struct {
@@ -42,6 +49,14 @@ func errSyntax(fn, input string) error {
 	return &u256Error{fn: fn, input: input, err: ErrSyntax}
 }
 
+func errEmptyInput(fn, input string) error {
+	return &u256Error{fn: fn, input: input, err: ErrEmptyInput}
+}
+
+func errInvalidNumber(fn, input string) error {
+	return &u256Error{fn: fn, input: input, err: ErrInvalidNumber}
+}
+
 func errMissingPrefix(fn, input string) error {
 	return &u256Error{fn: fn, input: input, err: ErrMissingPrefix}
 }
@@ -73,3 +88,11 @@ func errInvalidBase(fn string, base int) error {
 func errInvalidBitSize(fn string, bitSize int) error {
 	return &u256Error{fn: fn, input: strconv.Itoa(bitSize), err: ErrInvalidBitSize}
 }
+
+func errArithOverflow(fn string, operands ...string) error {
+	return &u256Error{fn: fn, input: strings.Join(operands, ", "), err: ErrOverflow}
+}
+
+func errDivByZero(fn string, operands ...string) error {
+	return &u256Error{fn: fn, input: strings.Join(operands, ", "), err: ErrDivisionByZero}
+}
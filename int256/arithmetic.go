@@ -31,12 +31,99 @@ func (z *Int) Mul(x, y *Int) *Int {
 	return z
 }
 
+// AddOverflow sets z to the sum x+y, and returns z and whether signed overflow occurred.
+// Overflow is only possible when x and y have the same sign and the sum does not.
+func (z *Int) AddOverflow(x, y *Int) (*Int, bool) {
+	xNeg, yNeg := x.IsNeg(), y.IsNeg()
+	z.value.Add(&x.value, &y.value)
+	return z, xNeg == yNeg && z.IsNeg() != xNeg
+}
+
+// SubOverflow sets z to the difference x-y, and returns z and whether signed overflow occurred.
+// Overflow is only possible when x and y have different signs and the difference takes the sign of y.
+func (z *Int) SubOverflow(x, y *Int) (*Int, bool) {
+	xNeg, yNeg := x.IsNeg(), y.IsNeg()
+	z.value.Sub(&x.value, &y.value)
+	return z, xNeg != yNeg && z.IsNeg() != xNeg
+}
+
+// MulOverflow sets z to the product x*y, and returns z and whether signed overflow occurred.
+// The product of MinInt256 and -1 overflows, since 2^255 is not representable.
+func (z *Int) MulOverflow(x, y *Int) (*Int, bool) {
+	var (
+		neg      = x.IsNeg() != y.IsNeg()
+		abs, lim uint256.Uint
+	)
+	_, overflow := abs.MulOverflow(x.Abs(), y.Abs())
+	// |MinInt256| is one larger than MaxInt256
+	lim.SetOne().Lsh(&lim, 255)
+	if !neg {
+		lim.Sub(&lim, uint256.One())
+	}
+	z.value.Mul(&x.value, &y.value)
+	return z, overflow || abs.Gt(&lim)
+}
+
+// NegOverflow sets z to -x, and returns z and whether signed overflow occurred.
+// Only MinInt256 overflows, in which case z is set to MinInt256.
+func (z *Int) NegOverflow(x *Int) (*Int, bool) {
+	xNeg := x.IsNeg()
+	z.Neg(x)
+	return z, xNeg && z.IsNeg()
+}
+
+// AbsOverflow sets z to |x|, and returns z and whether signed overflow occurred.
+// Only MinInt256 overflows, in which case z is set to MinInt256.
+func (z *Int) AbsOverflow(x *Int) (*Int, bool) {
+	if !x.IsNeg() {
+		return z.Set(x), false
+	}
+	return z.NegOverflow(x)
+}
+
+// SaturatingAdd sets z to the sum x+y, clamped to [MinInt256, MaxInt256], and returns z.
+func (z *Int) SaturatingAdd(x, y *Int) *Int {
+	xNeg := x.IsNeg()
+	if _, overflow := z.AddOverflow(x, y); overflow {
+		return z.saturate(xNeg)
+	}
+	return z
+}
+
+// SaturatingSub sets z to the difference x-y, clamped to [MinInt256, MaxInt256], and returns z.
+func (z *Int) SaturatingSub(x, y *Int) *Int {
+	xNeg := x.IsNeg()
+	if _, overflow := z.SubOverflow(x, y); overflow {
+		return z.saturate(xNeg)
+	}
+	return z
+}
+
+// SaturatingMul sets z to the product x*y, clamped to [MinInt256, MaxInt256], and returns z.
+func (z *Int) SaturatingMul(x, y *Int) *Int {
+	neg := x.IsNeg() != y.IsNeg()
+	if _, overflow := z.MulOverflow(x, y); overflow {
+		return z.saturate(neg)
+	}
+	return z
+}
+
+// saturate sets z to MinInt256 if neg, to MaxInt256 otherwise, and returns z.
+func (z *Int) saturate(neg bool) *Int {
+	if neg {
+		z.value.SetOne().Lsh(&z.value, 255)
+	} else {
+		z.value.SetAllOne().Rsh(&z.value, 1)
+	}
+	return z
+}
+
 func (z *Int) Abs() *uint256.Uint {
 	if z.Sign() >= 0 {
 		return &z.value
 	}
 	var absValue uint256.Uint
-	absValue.Sub(uint0, &z.value).Neg(&z.value)
+	absValue.Neg(&z.value)
 
 	return &absValue
 }
@@ -220,7 +307,7 @@ func (z *Int) Quo(x, y *Int) *Int {
 	// If x and y have different signs and there's a non-zero remainder,
 	// we need to round towards zero by adding 1 to the quotient magnitude.
 	if (xSign < 0) != (ySign < 0) && !r.IsZero() {
-		q.Add(&q, uint1)
+		q.Add(&q, uint256.One())
 	}
 
 	// Step 6: Adjust the sign of the result
@@ -316,9 +403,9 @@ func (z *Int) DivE(x, y *Int) *Int {
 
 	// Adjust the quotient if necessary
 	if r.Sign() >= 0 { return z }
-	if y.Sign() > 0  { return z.Sub(z, int1) }
+	if y.Sign() > 0  { return z.Sub(z, One()) }
 
-	return z.Add(z, int1)
+	return z.Add(z, One())
 }
 
 // ModE computes the Euclidean modulus of x by y, setting z to the result and returning z.
@@ -372,6 +459,69 @@ func (z *Int) ModE(x, y *Int) *Int {
 	return z.Sub(z, y)
 }
 
+// DivChecked sets z to the truncated quotient x/y and returns z.
+// Unlike Div it does not panic: a zero divisor is reported as ErrDivisionByZero,
+// and MinInt256 / -1, whose quotient 2^255 is not representable, as ErrOverflow.
+// On error z is left unchanged.
+func (z *Int) DivChecked(x, y *Int) (*Int, error) {
+	if err := checkDivision("DivChecked", x, y, true); err != nil {
+		return nil, err
+	}
+	return z.Div(x, y), nil
+}
+
+// QuoChecked is like Quo, but returns an error instead of panicking (see DivChecked).
+func (z *Int) QuoChecked(x, y *Int) (*Int, error) {
+	if err := checkDivision("QuoChecked", x, y, true); err != nil {
+		return nil, err
+	}
+	return z.Quo(x, y), nil
+}
+
+// DivEChecked is like DivE, but returns an error instead of panicking (see DivChecked).
+func (z *Int) DivEChecked(x, y *Int) (*Int, error) {
+	if err := checkDivision("DivEChecked", x, y, true); err != nil {
+		return nil, err
+	}
+	return z.DivE(x, y), nil
+}
+
+// RemChecked is like Rem, but returns ErrDivisionByZero instead of panicking when y == 0.
+// MinInt256 % -1 is 0, so the remainder never overflows.
+func (z *Int) RemChecked(x, y *Int) (*Int, error) {
+	if err := checkDivision("RemChecked", x, y, false); err != nil {
+		return nil, err
+	}
+	return z.Rem(x, y), nil
+}
+
+// ModChecked is like Mod, but returns ErrDivisionByZero instead of panicking when y == 0.
+func (z *Int) ModChecked(x, y *Int) (*Int, error) {
+	if err := checkDivision("ModChecked", x, y, false); err != nil {
+		return nil, err
+	}
+	return z.Mod(x, y), nil
+}
+
+// ModEChecked is like ModE, but returns ErrDivisionByZero instead of panicking when y == 0.
+func (z *Int) ModEChecked(x, y *Int) (*Int, error) {
+	if err := checkDivision("ModEChecked", x, y, false); err != nil {
+		return nil, err
+	}
+	return z.ModE(x, y), nil
+}
+
+// checkDivision reports a zero divisor and, for quotients, the MinInt256 / -1 overflow.
+func checkDivision(fn string, x, y *Int, quotient bool) error {
+	if y.IsZero() {
+		return errDivByZero(fn, x, y)
+	}
+	if quotient && y.Eq(NewInt(-1)) && x.Eq(MinInt256()) {
+		return errOverflow(fn, x, y)
+	}
+	return nil
+}
+
 // Sets z to the sum x + y, where z and x are uint256s and y is an int256.
 func AddDelta(z, x *uint256.Uint, y *Int) {
 	if y.Sign() >= 0 {
@@ -388,7 +538,7 @@ func AddDeltaOverflow(z, x *uint256.Uint, y *Int) bool {
 		_, overflow = z.AddOverflow(x, &y.value)
 	} else {
 		var absY uint256.Uint
-		absY.Sub(uint0, &y.value) // absY = -y.value
+		absY.Neg(&y.value) // absY = -y.value
 		_, overflow = z.SubOverflow(x, &absY)
 	}
 
@@ -1,6 +1,7 @@
 package int256
 
 import (
+	"errors"
 	"os"
 	"runtime/pprof"
 	"testing"
@@ -566,6 +567,65 @@ func TestModEByZero(t *testing.T) {
 	new(Int).ModE(x, y)
 }
 
+func TestDivisionChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func(z, x, y *Int) (*Int, error)
+		x, y    string
+		want    string
+		wantErr error
+	}{
+		{"DivChecked", (*Int).DivChecked, "-7", "2", "-3", nil},
+		{"DivChecked", (*Int).DivChecked, "7", "0", "", ErrDivisionByZero},
+		{"DivChecked", (*Int).DivChecked, minInt256Dec, "-1", "", ErrOverflow},
+		{"DivChecked", (*Int).DivChecked, minInt256Dec, "1", minInt256Dec, nil},
+		{"DivChecked", (*Int).DivChecked, "-" + maxInt256Dec, "-1", maxInt256Dec, nil},
+		{"QuoChecked", (*Int).QuoChecked, "-7", "2", "-4", nil},
+		{"QuoChecked", (*Int).QuoChecked, "7", "0", "", ErrDivisionByZero},
+		{"QuoChecked", (*Int).QuoChecked, minInt256Dec, "-1", "", ErrOverflow},
+		{"DivEChecked", (*Int).DivEChecked, "-7", "2", "-4", nil},
+		{"DivEChecked", (*Int).DivEChecked, "7", "0", "", ErrDivisionByZero},
+		{"DivEChecked", (*Int).DivEChecked, minInt256Dec, "-1", "", ErrOverflow},
+		{"RemChecked", (*Int).RemChecked, "-7", "2", "-1", nil},
+		{"RemChecked", (*Int).RemChecked, "7", "0", "", ErrDivisionByZero},
+		{"RemChecked", (*Int).RemChecked, minInt256Dec, "-1", "0", nil},
+		{"ModChecked", (*Int).ModChecked, "-7", "2", "1", nil},
+		{"ModChecked", (*Int).ModChecked, "7", "0", "", ErrDivisionByZero},
+		{"ModEChecked", (*Int).ModEChecked, "-7", "-3", "2", nil},
+		{"ModEChecked", (*Int).ModEChecked, "0", "0", "", ErrDivisionByZero},
+		{"ModEChecked", (*Int).ModEChecked, minInt256Dec, "-1", "0", nil},
+	}
+
+	for _, tc := range tests {
+		x := MustFromDecimal(tc.x)
+		y := MustFromDecimal(tc.y)
+		z := NewInt(42)
+
+		got, err := tc.fn(z, x, y)
+		if tc.wantErr != nil {
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("%s(%s, %s) error = %v, want %v", tc.name, tc.x, tc.y, err, tc.wantErr)
+			}
+			if got != nil || z.Neq(NewInt(42)) {
+				t.Errorf("%s(%s, %s) modified z on error", tc.name, tc.x, tc.y)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s(%s, %s) unexpected error: %v", tc.name, tc.x, tc.y, err)
+			continue
+		}
+		if got.ToString() != tc.want {
+			t.Errorf("%s(%s, %s) = %v, want %v", tc.name, tc.x, tc.y, got.ToString(), tc.want)
+		}
+	}
+
+	_, err := New().DivChecked(NewInt(-7), NewInt(0))
+	if want := "DivChecked: -7, 0: division by zero"; err.Error() != want {
+		t.Errorf("error message = %q, want %q", err.Error(), want)
+	}
+}
+
 func TestLargeNumbers(t *testing.T) {
 	x, _ := new(Int).SetString("123456789012345678901234567890")
 	y, _ := new(Int).SetString("987654321098765432109876543210")
@@ -612,6 +672,129 @@ func TestAbs(t *testing.T) {
 	}
 }
 
+const (
+	maxInt256Dec = "57896044618658097711785492504343953926634992332820282019728792003956564819967"
+	minInt256Dec = "-57896044618658097711785492504343953926634992332820282019728792003956564819968"
+)
+
+func TestOverflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(z, x, y *Int) (*Int, bool)
+		x, y     string
+		want     string
+		overflow bool
+	}{
+		{"AddOverflow", (*Int).AddOverflow, "1", "-2", "-1", false},
+		{"AddOverflow", (*Int).AddOverflow, maxInt256Dec, "1", minInt256Dec, true},
+		{"AddOverflow", (*Int).AddOverflow, minInt256Dec, "-1", maxInt256Dec, true},
+		{"AddOverflow", (*Int).AddOverflow, minInt256Dec, maxInt256Dec, "-1", false},
+		{"AddOverflow", (*Int).AddOverflow, minInt256Dec, minInt256Dec, "0", true},
+		{"SubOverflow", (*Int).SubOverflow, "1", "2", "-1", false},
+		{"SubOverflow", (*Int).SubOverflow, minInt256Dec, "1", maxInt256Dec, true},
+		{"SubOverflow", (*Int).SubOverflow, "0", minInt256Dec, minInt256Dec, true},
+		{"SubOverflow", (*Int).SubOverflow, "-1", minInt256Dec, maxInt256Dec, false},
+		{"SubOverflow", (*Int).SubOverflow, maxInt256Dec, "-1", minInt256Dec, true},
+		{"MulOverflow", (*Int).MulOverflow, "-3", "7", "-21", false},
+		{"MulOverflow", (*Int).MulOverflow, "0", "-7", "0", false},
+		{"MulOverflow", (*Int).MulOverflow, minInt256Dec, "-1", minInt256Dec, true},
+		{"MulOverflow", (*Int).MulOverflow, "-1", minInt256Dec, minInt256Dec, true},
+		{"MulOverflow", (*Int).MulOverflow, minInt256Dec, "1", minInt256Dec, false},
+		{"MulOverflow", (*Int).MulOverflow, maxInt256Dec, "-1", "-" + maxInt256Dec, false},
+		{"MulOverflow", (*Int).MulOverflow, maxInt256Dec, "2", "-2", true},
+		{"MulOverflow", (*Int).MulOverflow, "-28948022309329048855892746252171976963317496166410141009864396001978282409984", "2", minInt256Dec, false}, // -2^254 * 2
+		{"MulOverflow", (*Int).MulOverflow, "28948022309329048855892746252171976963317496166410141009864396001978282409984", "2", minInt256Dec, true},   // 2^254 * 2
+		{"MulOverflow", (*Int).MulOverflow, "340282366920938463463374607431768211456", "-340282366920938463463374607431768211456", "0", true},           // 2^128 * -2^128
+	}
+
+	for _, tc := range tests {
+		x := MustFromDecimal(tc.x)
+		y := MustFromDecimal(tc.y)
+
+		got, overflow := tc.fn(New(), x, y)
+
+		if got.ToString() != tc.want || overflow != tc.overflow {
+			t.Errorf("%s(%s, %s) = (%v, %v), want (%v, %v)", tc.name, tc.x, tc.y, got.ToString(), overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestNegAbsOverflow(t *testing.T) {
+	tests := []struct {
+		x        string
+		neg, abs string
+		overflow bool
+	}{
+		{"0", "0", "0", false},
+		{"5", "-5", "5", false},
+		{"-5", "5", "5", false},
+		{maxInt256Dec, "-" + maxInt256Dec, maxInt256Dec, false},
+		{"-" + maxInt256Dec, maxInt256Dec, maxInt256Dec, false},
+		{minInt256Dec, minInt256Dec, minInt256Dec, true},
+	}
+
+	for _, tc := range tests {
+		x := MustFromDecimal(tc.x)
+
+		got, overflow := New().NegOverflow(x)
+		if got.ToString() != tc.neg || overflow != tc.overflow {
+			t.Errorf("NegOverflow(%s) = (%v, %v), want (%v, %v)", tc.x, got.ToString(), overflow, tc.neg, tc.overflow)
+		}
+
+		got, overflow = New().AbsOverflow(x)
+		if got.ToString() != tc.abs || overflow != tc.overflow {
+			t.Errorf("AbsOverflow(%s) = (%v, %v), want (%v, %v)", tc.x, got.ToString(), overflow, tc.abs, tc.overflow)
+		}
+	}
+}
+
+func TestSaturating(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(z, x, y *Int) *Int
+		x, y string
+		want string
+	}{
+		{"SaturatingAdd", (*Int).SaturatingAdd, "1", "-2", "-1"},
+		{"SaturatingAdd", (*Int).SaturatingAdd, maxInt256Dec, "1", maxInt256Dec},
+		{"SaturatingAdd", (*Int).SaturatingAdd, maxInt256Dec, maxInt256Dec, maxInt256Dec},
+		{"SaturatingAdd", (*Int).SaturatingAdd, minInt256Dec, "-1", minInt256Dec},
+		{"SaturatingAdd", (*Int).SaturatingAdd, minInt256Dec, maxInt256Dec, "-1"},
+		{"SaturatingSub", (*Int).SaturatingSub, "1", "2", "-1"},
+		{"SaturatingSub", (*Int).SaturatingSub, minInt256Dec, "1", minInt256Dec},
+		{"SaturatingSub", (*Int).SaturatingSub, "0", minInt256Dec, maxInt256Dec},
+		{"SaturatingSub", (*Int).SaturatingSub, "-1", minInt256Dec, maxInt256Dec},
+		{"SaturatingSub", (*Int).SaturatingSub, maxInt256Dec, "-1", maxInt256Dec},
+		{"SaturatingMul", (*Int).SaturatingMul, "-3", "7", "-21"},
+		{"SaturatingMul", (*Int).SaturatingMul, "0", minInt256Dec, "0"},
+		{"SaturatingMul", (*Int).SaturatingMul, minInt256Dec, "1", minInt256Dec},
+		{"SaturatingMul", (*Int).SaturatingMul, minInt256Dec, "-1", maxInt256Dec},
+		{"SaturatingMul", (*Int).SaturatingMul, maxInt256Dec, "-1", "-" + maxInt256Dec},
+		{"SaturatingMul", (*Int).SaturatingMul, maxInt256Dec, "2", maxInt256Dec},
+		{"SaturatingMul", (*Int).SaturatingMul, maxInt256Dec, "-2", minInt256Dec},
+		{"SaturatingMul", (*Int).SaturatingMul, "-28948022309329048855892746252171976963317496166410141009864396001978282409984", "2", minInt256Dec}, // -2^254 * 2
+		{"SaturatingMul", (*Int).SaturatingMul, "28948022309329048855892746252171976963317496166410141009864396001978282409984", "2", maxInt256Dec},  // 2^254 * 2
+	}
+
+	for _, tc := range tests {
+		x := MustFromDecimal(tc.x)
+		y := MustFromDecimal(tc.y)
+
+		got := tc.fn(New(), x, y)
+
+		if got.ToString() != tc.want {
+			t.Errorf("%s(%s, %s) = %v, want %v", tc.name, tc.x, tc.y, got.ToString(), tc.want)
+		}
+	}
+
+	if got := MaxInt256().ToString(); got != maxInt256Dec {
+		t.Errorf("MaxInt256() = %s, want %s", got, maxInt256Dec)
+	}
+	if got := MinInt256().ToString(); got != minInt256Dec {
+		t.Errorf("MinInt256() = %s, want %s", got, minInt256Dec)
+	}
+}
+
 // Benchmarks
 
 func BenchmarkAdd(b *testing.B) {
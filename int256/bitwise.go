@@ -1,5 +1,9 @@
 package int256
 
+import (
+	"github.com/gnoswap-labs/uint256"
+)
+
 // Not returns the bitwise NOT of x, setting z to the result and returning z.
 func (z *Int) Not(x *Int) *Int {
 	z.value.Not(&x.value)
@@ -24,8 +28,17 @@ func (z *Int) Xor(x, y *Int) *Int {
 	return z
 }
 
-// Rsh returns the result of shifting x right by n bits, setting z to the result and returning z.
+// Rsh returns the result of arithmetically shifting x right by n bits, setting z to the result and returning z.
+// The sign bit is replicated into the vacated bits, so the result is x / 2^n rounded towards negative infinity,
+// the same as big.Int.Rsh. Shifting a negative x by 255 bits or more yields -1.
 func (z *Int) Rsh(x *Int, n uint) *Int {
+	z.value.SRsh(&x.value, n)
+	return z
+}
+
+// URsh returns the result of logically shifting x right by n bits, setting z to the result and returning z.
+// The vacated bits are filled with zeros, so a negative x becomes non-negative for any n > 0.
+func (z *Int) URsh(x *Int, n uint) *Int {
 	z.value.Rsh(&x.value, n)
 	return z
 }
@@ -35,3 +48,14 @@ func (z *Int) Lsh(x *Int, n uint) *Int {
 	z.value.Lsh(&x.value, n)
 	return z
 }
+
+// LshOverflow sets z to x shifted left by n bits, and returns z and whether signed overflow occurred.
+// Overflow is reported when x * 2^n does not fit in an Int, i.e. when shifting the result back
+// arithmetically does not restore x.
+func (z *Int) LshOverflow(x *Int, n uint) (*Int, bool) {
+	var back uint256.Uint
+	xValue := x.value // x may alias z
+	z.value.Lsh(&x.value, n)
+	back.SRsh(&z.value, n)
+	return z, back.Neq(&xValue)
+}
@@ -1,6 +1,7 @@
 package int256
 
 import (
+	"math/big"
 	"testing"
 )
 
@@ -92,12 +93,18 @@ func TestBitwise_Xor(t *testing.T) {
 
 func TestBitwise_Rsh(t *testing.T) {
 	tests := []struct {
-		x string
-		n      uint
+		x    string
+		n    uint
 		want string
 	}{
-		{"5", 1, "2"}, // 0101 >> 1 = 0010
+		{"5", 1, "2"},  // 0101 >> 1 = 0010
 		{"42", 3, "5"}, // 00101010 >> 3 = 00000101
+		{"-5", 1, "-3"},
+		{"-1", 1, "-1"},
+		{"-1", 300, "-1"},
+		{"-42", 3, "-6"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", 255, "-1"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", 128, "-170141183460469231731687303715884105728"},
 	}
 
 	for _, tt := range tests {
@@ -112,14 +119,40 @@ func TestBitwise_Rsh(t *testing.T) {
 	}
 }
 
+func TestBitwise_URsh(t *testing.T) {
+	tests := []struct {
+		x    string
+		n    uint
+		want string
+	}{
+		{"5", 1, "2"},
+		{"-1", 0, "-1"},
+		{"-1", 1, "57896044618658097711785492504343953926634992332820282019728792003956564819967"},
+		{"-1", 255, "1"},
+		{"-1", 256, "0"},
+	}
+
+	for _, tt := range tests {
+		x, _ := FromDecimal(tt.x)
+		want, _ := FromDecimal(tt.want)
+
+		got := new(Int).URsh(x, tt.n)
+
+		if got.Neq(want) {
+			t.Errorf("URsh(%s, %d) = %s, want %s", x.ToString(), tt.n, got.ToString(), want.ToString())
+		}
+	}
+}
+
 func TestBitwise_Lsh(t *testing.T) {
 	tests := []struct {
-		x  		string
-		n      	uint
-		want 	string
+		x    string
+		n    uint
+		want string
 	}{
-		{"5", 2, "20"}, // 0101 << 2 = 10100
+		{"5", 2, "20"},    // 0101 << 2 = 10100
 		{"42", 5, "1344"}, // 00101010 << 5 = 10101000000
+		{"-5", 2, "-20"},
 	}
 
 	for _, tt := range tests {
@@ -134,6 +167,74 @@ func TestBitwise_Lsh(t *testing.T) {
 	}
 }
 
+// shiftOperands returns a spread of values around the interesting boundaries:
+// zero, +-1, +-2^k and +-(2^k-1) for every k, and MinInt256/MaxInt256.
+func shiftOperands() []*big.Int {
+	var ops []*big.Int
+	for k := uint(0); k < 256; k++ {
+		p := new(big.Int).Lsh(big.NewInt(1), k)
+		m := new(big.Int).Sub(p, big.NewInt(1))
+		for _, v := range []*big.Int{p, m} {
+			if v.BitLen() < 256 {
+				ops = append(ops, v, new(big.Int).Neg(v))
+			}
+		}
+	}
+	// MinInt256, and a few values with mixed bit patterns
+	ops = append(ops, new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255)))
+	for _, s := range []string{
+		"-12345678901234567890123456789012345678901234567890",
+		"-887272",
+		"-340282366920938463463374607431768211457",
+		"-28948022309329048855892746252171976963317496166410141009864396001978282409985",
+	} {
+		v, _ := new(big.Int).SetString(s, 10)
+		ops = append(ops, v, new(big.Int).Neg(v))
+	}
+	return ops
+}
+
+func TestBitwise_Rsh_Big(t *testing.T) {
+	for _, b := range shiftOperands() {
+		x := MustFromDecimal(b.String())
+		for n := uint(0); n <= 300; n++ {
+			want := new(big.Int).Rsh(b, n)
+
+			got := new(Int).Rsh(x, n)
+
+			if got.ToString() != want.String() {
+				t.Fatalf("Rsh(%s, %d) = %s, want %s", b, n, got.ToString(), want)
+			}
+		}
+	}
+}
+
+func TestBitwise_LshOverflow_Big(t *testing.T) {
+	var (
+		max = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+		min = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+		mod = new(big.Int).Lsh(big.NewInt(1), 256)
+	)
+	for _, b := range shiftOperands() {
+		x := MustFromDecimal(b.String())
+		for n := uint(0); n <= 300; n++ {
+			exact := new(big.Int).Lsh(b, n)
+			wantOverflow := exact.Cmp(max) > 0 || exact.Cmp(min) < 0
+			// wrap the exact result into [MinInt256, MaxInt256]
+			want := new(big.Int).Mod(exact, mod)
+			if want.Cmp(max) > 0 {
+				want.Sub(want, mod)
+			}
+
+			got, overflow := new(Int).LshOverflow(x, n)
+
+			if got.ToString() != want.String() || overflow != wantOverflow {
+				t.Fatalf("LshOverflow(%s, %d) = (%s, %v), want (%s, %v)", b, n, got.ToString(), overflow, want, wantOverflow)
+			}
+		}
+	}
+}
+
 func Benchmark_Bitwise_And(b *testing.B) {
 	x, _ := FromDecimal("123456789")
 	y, _ := FromDecimal("987654321")
@@ -1,7 +1,10 @@
 package int256
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"math/big"
 
 	"github.com/gnoswap-labs/uint256"
 )
@@ -53,6 +56,221 @@ func (z *Int) Int64() int64 {
 	}
 }
 
+// Int64WithOverflow returns the lower 64-bits of z as an int64 and whether overflow occurred,
+// i.e. whether z is outside of [math.MinInt64, math.MaxInt64].
+func (z *Int) Int64WithOverflow() (int64, bool) {
+	return int64(z.value.Uint64()), !z.IsInt64()
+}
+
+// Uint64WithOverflow returns the lower 64-bits of z and whether overflow occurred,
+// i.e. whether z is negative or larger than math.MaxUint64.
+func (z *Int) Uint64WithOverflow() (uint64, bool) {
+	return z.value.Uint64(), !z.IsUint64()
+}
+
+// IsInt64 reports whether z can be represented as an int64.
+func (z *Int) IsInt64() bool {
+	return z.fitsBytes(8)
+}
+
+// IsUint64 reports whether z can be represented as a uint64.
+func (z *Int) IsUint64() bool {
+	return !z.IsNeg() && z.value.IsUint64()
+}
+
+// ToInt32 returns z as an int32.
+// If z is outside of [math.MinInt32, math.MaxInt32], it returns 0 and ErrRange.
+func (z *Int) ToInt32() (int32, error) {
+	if !z.fitsBytes(4) {
+		return 0, errRange("ToInt32", z.ToString())
+	}
+	return int32(z.value.Uint64()), nil
+}
+
+// ToInt24 returns z as an int32 holding a 24-bit signed value, such as a tick index.
+// If z is outside of [-2^23, 2^23-1], it returns 0 and ErrRange.
+func (z *Int) ToInt24() (int32, error) {
+	if !z.fitsBytes(3) {
+		return 0, errRange("ToInt24", z.ToString())
+	}
+	return int32(z.value.Uint64()), nil
+}
+
+// fitsBytes reports whether z survives truncation to a signed n-byte integer,
+// i.e. whether sign-extending its low n bytes gives back z.
+func (z *Int) fitsBytes(n uint64) bool {
+	var ext uint256.Uint
+	ext.ExtendSign(&z.value, uint256.NewUint(n-1))
+	return ext.Eq(&z.value)
+}
+
+// SetStringBase sets z to the value of s, interpreted in the given base,
+// and returns z and a boolean indicating success. If it fails, the value
+// of z is undefined but the returned value is nil.
+//
+// It follows big.Int.SetString semantics (see uint256.Uint.SetString for the
+// accepted bases and prefixes), with an optional leading "+" or "-" sign.
+// Values outside of [MinInt256, MaxInt256] are not accepted.
+// The name differs from big.Int because SetString already parses decimals.
+func (z *Int) SetStringBase(s string, base int) (*Int, bool) {
+	neg := len(s) > 0 && s[0] == '-'
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+	// reject a second sign, which Uint.SetString would otherwise strip
+	if len(s) > 0 && s[0] == '+' {
+		return nil, false
+	}
+	if _, ok := z.value.SetString(s, base); !ok || !z.setSigned(neg) {
+		return nil, false
+	}
+	return z, true
+}
+
+// SetFromString sets z from s, which is either a decimal number or a
+// 0x-prefixed hexadecimal number with an optional leading "-", applying the
+// leniency given by opts to the magnitude (see uint256.ParseOptions).
+// With opts.AllowSign a leading "+" is accepted as well.
+// Values outside of [MinInt256, MaxInt256] are not accepted.
+// Errors wrap ErrEmptyInput, ErrInvalidNumber or ErrRange and quote s unchanged.
+func (z *Int) SetFromString(s string, opts uint256.ParseOptions) error {
+	const fn = "SetFromString"
+	s0 := s
+
+	if s == "" {
+		return errEmptyInput(fn, s0)
+	}
+	neg := s[0] == '-'
+	if neg || (opts.AllowSign && s[0] == '+') {
+		s = s[1:]
+		if s == "" {
+			return errInvalidNumber(fn, s0)
+		}
+	}
+	// the magnitude must not carry a second sign
+	opts.AllowSign = false
+	if err := z.value.SetFromString(s, opts); err != nil {
+		// report the failure against the signed input rather than the bare magnitude
+		return &i256Error{fn: fn, input: s0, err: errors.Unwrap(err)}
+	}
+	if !z.setSigned(neg) {
+		return errRange(fn, s0)
+	}
+	return nil
+}
+
+// FromString is a convenience-constructor to create an Int from a
+// decimal or 0x-prefixed hexadecimal string, applying the leniency given by opts.
+func FromString(s string, opts uint256.ParseOptions) (*Int, error) {
+	z := &Int{}
+	if err := z.SetFromString(s, opts); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// setSigned turns the magnitude held in z into a signed value,
+// and reports whether it is within [MinInt256, MaxInt256].
+func (z *Int) setSigned(neg bool) bool {
+	// the magnitude may reach 2^255 only for negative numbers
+	var lim uint256.Uint
+	lim.SetOne().Lsh(&lim, 255)
+	if z.value.Gt(&lim) || (!neg && z.value.Eq(&lim)) {
+		return false
+	}
+	if neg {
+		z.value.Neg(&z.value)
+	}
+	return true
+}
+
+// Text returns the string representation of z in the given base,
+// prefixed with a minus sign if z is negative.
+// Base must be between 2 and 36, inclusive (see uint256.Uint.Text).
+// It returns "<nil>" if z is nil.
+func (z *Int) Text(base int) string {
+	if z == nil {
+		return "<nil>"
+	}
+	s := z.Abs().Text(base)
+	if z.IsNeg() {
+		return "-" + s
+	}
+	return s
+}
+
+// String returns the decimal representation of z, or "<nil>" if z is nil.
+// It implements fmt.Stringer.
+func (z *Int) String() string {
+	if z == nil {
+		return "<nil>"
+	}
+	return z.ToString()
+}
+
+// Format implements fmt.Formatter with the verbs and flags of big.Int.Format:
+// 'b', 'o', 'O', 'd', 'x', 'X' (plus 's' and 'v' as decimal), sign control,
+// '#' prefixes, precision, field width and padding.
+// Formatting goes through ToBig, so the output is identical to big.Int's.
+func (z *Int) Format(s fmt.State, ch rune) {
+	switch ch {
+	case 'b', 'o', 'O', 'd', 's', 'v', 'x', 'X':
+	default:
+		// unknown format
+		fmt.Fprintf(s, "%%!%c(int256.Int=%s)", ch, z.String())
+		return
+	}
+	if z == nil {
+		fmt.Fprint(s, "<nil>")
+		return
+	}
+	z.ToBig().Format(s, ch)
+}
+
+// ToBig returns a big.Int version of z.
+// Return `nil` if z is nil
+func (z *Int) ToBig() *big.Int {
+	if z == nil {
+		return nil
+	}
+	b := z.Abs().ToBig()
+	if z.IsNeg() {
+		b.Neg(b)
+	}
+	return b
+}
+
+// FromBig is a convenience-constructor from big.Int.
+// Returns a new Int and whether overflow occurred, i.e. whether b is outside of [MinInt256, MaxInt256].
+// On overflow the result holds the low 256 bits of b in two's complement.
+func FromBig(b *big.Int) (*Int, bool) {
+	z := &Int{}
+	overflow := z.SetFromBig(b)
+	return z, overflow
+}
+
+// MustFromBig is a convenience-constructor from big.Int.
+// Returns a new Int and panics if overflow occurred.
+func MustFromBig(b *big.Int) *Int {
+	z := &Int{}
+	if z.SetFromBig(b) {
+		panic("overflow")
+	}
+	return z
+}
+
+// SetFromBig sets z to the two's complement of b truncated to 256 bits,
+// and returns whether overflow occurred.
+func (z *Int) SetFromBig(b *big.Int) bool {
+	neg := b.Sign() < 0
+	overflow := z.value.SetFromBig(new(big.Int).Abs(b))
+	if neg {
+		z.value.Neg(&z.value)
+	}
+	// within range, the sign bit of the result must match the sign of b
+	return overflow || (!z.IsZero() && z.IsNeg() != neg)
+}
+
 // Neg sets z to -x and returns z.)
 func (z *Int) Neg(x *Int) *Int {
 	if x.IsZero() {
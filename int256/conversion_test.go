@@ -1,6 +1,10 @@
 package int256
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/gnoswap-labs/uint256"
@@ -111,6 +115,214 @@ func TestInt64_Panic(t *testing.T) {
 	}
 }
 
+func TestInt64WithOverflow(t *testing.T) {
+	tests := []struct {
+		x        string
+		want     int64
+		overflow bool
+	}{
+		{"0", 0, false},
+		{"-1", -1, false},
+		{"9223372036854775807", 9223372036854775807, false},
+		{"-9223372036854775808", -9223372036854775808, false},
+		{"9223372036854775808", -9223372036854775808, true},
+		{"-9223372036854775809", 9223372036854775807, true},
+		{"18446744073709551616", 0, true},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", 0, true},
+	}
+
+	for _, tt := range tests {
+		z := MustFromDecimal(tt.x)
+
+		got, overflow := z.Int64WithOverflow()
+		if got != tt.want || overflow != tt.overflow {
+			t.Errorf("Int64WithOverflow(%s) = (%d, %v), want (%d, %v)", tt.x, got, overflow, tt.want, tt.overflow)
+		}
+		if z.IsInt64() == tt.overflow {
+			t.Errorf("IsInt64(%s) = %v, want %v", tt.x, z.IsInt64(), !tt.overflow)
+		}
+	}
+}
+
+func TestUint64WithOverflow(t *testing.T) {
+	tests := []struct {
+		x        string
+		want     uint64
+		overflow bool
+	}{
+		{"0", 0, false},
+		{"18446744073709551615", 18446744073709551615, false},
+		{"18446744073709551616", 0, true},
+		{"-1", 18446744073709551615, true},
+		{"-18446744073709551616", 0, true},
+	}
+
+	for _, tt := range tests {
+		z := MustFromDecimal(tt.x)
+
+		got, overflow := z.Uint64WithOverflow()
+		if got != tt.want || overflow != tt.overflow {
+			t.Errorf("Uint64WithOverflow(%s) = (%d, %v), want (%d, %v)", tt.x, got, overflow, tt.want, tt.overflow)
+		}
+		if z.IsUint64() == tt.overflow {
+			t.Errorf("IsUint64(%s) = %v, want %v", tt.x, z.IsUint64(), !tt.overflow)
+		}
+	}
+}
+
+func TestToInt32(t *testing.T) {
+	tests := []struct {
+		x       string
+		want    int32
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"-887272", -887272, false},
+		{"2147483647", 2147483647, false},
+		{"-2147483648", -2147483648, false},
+		{"2147483648", 0, true},
+		{"-2147483649", 0, true},
+		{"-4294967296", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := MustFromDecimal(tt.x).ToInt32()
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ToInt32(%s) = (%d, %v), want %d", tt.x, got, err, tt.want)
+		}
+		if err != nil && !errors.Is(err, ErrRange) {
+			t.Errorf("ToInt32(%s) error = %v, want %v", tt.x, err, ErrRange)
+		}
+	}
+}
+
+func TestToInt24(t *testing.T) {
+	tests := []struct {
+		x       string
+		want    int32
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"887272", 887272, false},
+		{"-887272", -887272, false},
+		{"8388607", 8388607, false},
+		{"-8388608", -8388608, false},
+		{"8388608", 0, true},
+		{"-8388609", 0, true},
+		{"16777215", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := MustFromDecimal(tt.x).ToInt24()
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ToInt24(%s) = (%d, %v), want %d", tt.x, got, err, tt.want)
+		}
+		if err != nil && !errors.Is(err, ErrRange) {
+			t.Errorf("ToInt24(%s) error = %v, want %v", tt.x, err, ErrRange)
+		}
+	}
+}
+
+func TestFromBig(t *testing.T) {
+	tests := []struct {
+		x        string
+		want     string
+		overflow bool
+	}{
+		{"0", "0", false},
+		{"-1", "-1", false},
+		{"-18446744073709551616", "-18446744073709551616", false},
+		{maxInt256Dec, maxInt256Dec, false},
+		{minInt256Dec, minInt256Dec, false},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819968", minInt256Dec, true},  // 2^255
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819969", maxInt256Dec, true}, // -2^255 - 1
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639935", "-1", true},         // 2^256 - 1
+		{"-115792089237316195423570985008687907853269984665640564039457584007913129639936", "0", true},         // -2^256
+	}
+
+	for _, tt := range tests {
+		b, _ := new(big.Int).SetString(tt.x, 10)
+
+		got, overflow := FromBig(b)
+
+		if got.ToString() != tt.want || overflow != tt.overflow {
+			t.Errorf("FromBig(%s) = (%s, %v), want (%s, %v)", tt.x, got.ToString(), overflow, tt.want, tt.overflow)
+		}
+		if !tt.overflow {
+			if back := got.ToBig(); back.Cmp(b) != 0 {
+				t.Errorf("FromBig(%s).ToBig() = %s", tt.x, back)
+			}
+			if must := MustFromBig(b); must.Neq(got) {
+				t.Errorf("MustFromBig(%s) = %s, want %s", tt.x, must.ToString(), got.ToString())
+			}
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("MustFromBig(2^255) did not panic")
+		}
+	}()
+	MustFromBig(new(big.Int).Lsh(big.NewInt(1), 255))
+}
+
+func TestTextSetStringBase(t *testing.T) {
+	values := []string{
+		"0",
+		"1",
+		"-1",
+		"-35",
+		"-887272",
+		"18446744073709551616",
+		"-340282366920938463463374607431768211456",
+		maxInt256Dec,
+		minInt256Dec,
+	}
+
+	for _, v := range values {
+		x := MustFromDecimal(v)
+		b, _ := new(big.Int).SetString(v, 10)
+
+		for base := 2; base <= 36; base++ {
+			want := b.Text(base)
+			if got := x.Text(base); got != want {
+				t.Errorf("Text(%s, %d) = %s, want %s", v, base, got, want)
+			}
+
+			got, ok := new(Int).SetStringBase(want, base)
+			if !ok || got.Neq(x) {
+				t.Errorf("SetStringBase(%s, %d) = (%v, %v), want %s", want, base, got, ok, v)
+			}
+		}
+	}
+
+	tests := []struct {
+		s    string
+		base int
+		want string
+		ok   bool
+	}{
+		{"-0x10", 0, "-16", true},
+		{"+0b11", 0, "3", true},
+		{"-0", 0, "0", true},
+		{"-0x8000000000000000000000000000000000000000000000000000000000000000", 0, minInt256Dec, true},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", 0, "", false},
+		{"-0x8000000000000000000000000000000000000000000000000000000000000001", 0, "", false},
+		{"--1", 0, "", false},
+		{"-+1", 0, "", false},
+		{"+-1", 0, "", false},
+		{"-", 0, "", false},
+		{"1_0", 10, "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := new(Int).SetStringBase(tt.s, tt.base)
+		if ok != tt.ok || (ok && got.ToString() != tt.want) {
+			t.Errorf("SetStringBase(%q, %d) = (%v, %v), want (%s, %v)", tt.s, tt.base, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
 func TestNeg(t *testing.T) {
 	tests := []struct {
 		x    string
@@ -212,3 +424,31 @@ func TestToString(t *testing.T) {
 		}
 	}
 }
+
+func TestFormat(t *testing.T) {
+	formats := []string{
+		"%d", "%x", "%X", "%#x", "%o", "%O", "%b", "%v", "%s",
+		"%+d", "% d", "%10d", "%-10d|", "%010d", "%.5d", "%#010x", "%+#x", "%q",
+	}
+	values := []string{"0", "1", "-1", "-255", "-18446744073709551616", maxInt256Dec, minInt256Dec}
+
+	for _, v := range values {
+		x := MustFromDecimal(v)
+		b, _ := new(big.Int).SetString(v, 10)
+
+		for _, f := range formats {
+			want := strings.Replace(fmt.Sprintf(f, b), "big.Int", "int256.Int", 1)
+			if got := fmt.Sprintf(f, x); got != want {
+				t.Errorf("Sprintf(%q, %s) = %q, want %q", f, v, got, want)
+			}
+		}
+	}
+
+	var nilInt *Int
+	if got := fmt.Sprintf("%d|%v", nilInt, nilInt); got != "<nil>|<nil>" {
+		t.Errorf("Sprintf(nil) = %q", got)
+	}
+	if got := fmt.Sprint(NewInt(-42)); got != "-42" {
+		t.Errorf("Sprint(-42) = %q, want -42", got)
+	}
+}
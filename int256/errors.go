@@ -0,0 +1,54 @@
+package int256
+
+import (
+	"errors"
+
+	"github.com/gnoswap-labs/uint256"
+)
+
+// The sentinel errors are shared with the uint256 package,
+// so errors.Is works the same way for signed and unsigned results.
+var (
+	ErrOverflow       = uint256.ErrOverflow
+	ErrDivisionByZero = uint256.ErrDivisionByZero
+	ErrRange          = uint256.ErrRange
+	ErrEmptyInput     = uint256.ErrEmptyInput
+	ErrInvalidNumber  = uint256.ErrInvalidNumber
+)
+
+// ErrInt128Range is the signed counterpart of uint256.ErrUint128Range.
+var ErrInt128Range = errors.New("number out of int128 range")
+
+type i256Error struct {
+	fn    string // function name
+	input string
+	err   error
+}
+
+func (e *i256Error) Error() string {
+	return e.fn + ": " + e.input + ": " + e.err.Error()
+}
+
+func (e *i256Error) Unwrap() error {
+	return e.err
+}
+
+func errOverflow(fn string, x, y *Int) error {
+	return &i256Error{fn: fn, input: x.ToString() + ", " + y.ToString(), err: ErrOverflow}
+}
+
+func errDivByZero(fn string, x, y *Int) error {
+	return &i256Error{fn: fn, input: x.ToString() + ", " + y.ToString(), err: ErrDivisionByZero}
+}
+
+func errRange(fn, input string) error {
+	return &i256Error{fn: fn, input: input, err: ErrRange}
+}
+
+func errEmptyInput(fn, input string) error {
+	return &i256Error{fn: fn, input: input, err: ErrEmptyInput}
+}
+
+func errInvalidNumber(fn, input string) error {
+	return &i256Error{fn: fn, input: input, err: ErrInvalidNumber}
+}
@@ -0,0 +1,268 @@
+package int256
+
+import (
+	"github.com/gnoswap-labs/uint256"
+)
+
+// Int128 is a 128-bit signed integer, stored in two's complement form.
+// It is the companion type of uint256.Uint128, e.g. for liquidity deltas.
+type Int128 struct {
+	value uint256.Uint128
+}
+
+// NewInt128 allocates and returns a new Int128 set to the value of the provided int64.
+func NewInt128(x int64) *Int128 {
+	return new(Int128).SetInt64(x)
+}
+
+// MaxInt128 returns a new Int128 set to 2^127 - 1.
+func MaxInt128() *Int128 {
+	z := new(Int128)
+	z.value.SetWords(1<<63-1, 1<<64-1)
+	return z
+}
+
+// MinInt128 returns a new Int128 set to -2^127.
+func MinInt128() *Int128 {
+	z := new(Int128)
+	z.value.SetWords(1<<63, 0)
+	return z
+}
+
+// Int128FromInt is a convenience-constructor to create an Int128 from an Int.
+// Values outside of the int128 range are not accepted.
+func Int128FromInt(x *Int) (*Int128, error) {
+	var z Int128
+	if _, err := z.SetInt(x); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// SetInt64 sets z to the value of the provided int64.
+func (z *Int128) SetInt64(x int64) *Int128 {
+	hi := uint64(0)
+	if x < 0 {
+		hi = 1<<64 - 1
+	}
+	z.value.SetWords(hi, uint64(x))
+	return z
+}
+
+// SetInt sets z to the value of x, and returns z.
+// If x is outside of the int128 range, z is left unchanged and ErrInt128Range is returned.
+func (z *Int128) SetInt(x *Int) (*Int128, error) {
+	// x fits in 128 bits iff sign-extending its low 16 bytes gives back x.
+	var ext uint256.Uint
+	if ext.ExtendSign(&x.value, uint256.NewUint(15)).Neq(&x.value) {
+		return z, ErrInt128Range
+	}
+	z.value.SetUintOverflow(&x.value)
+	return z, nil
+}
+
+// ToInt returns a new Int set to the value of z.
+func (z *Int128) ToInt() *Int {
+	res := New()
+	res.value.ExtendSign(z.value.ToUint(), uint256.NewUint(15))
+	return res
+}
+
+// SetUint128 sets z to the value of x, and returns z.
+// If x is larger than MaxInt128, z is left unchanged and ErrInt128Range is returned.
+func (z *Int128) SetUint128(x *uint256.Uint128) (*Int128, error) {
+	if x.Hi()>>63 != 0 {
+		return z, ErrInt128Range
+	}
+	z.value.Set(x)
+	return z, nil
+}
+
+// ToUint128 returns a new Uint128 set to the value of z.
+// If z is negative, it returns nil and ErrInt128Range.
+func (z *Int128) ToUint128() (*uint256.Uint128, error) {
+	if z.IsNeg() {
+		return nil, ErrInt128Range
+	}
+	return new(uint256.Uint128).Set(&z.value), nil
+}
+
+// Set sets z to x and returns z.
+func (z *Int128) Set(x *Int128) *Int128 {
+	z.value.Set(&x.value)
+	return z
+}
+
+// Sign returns -1 for negative numbers, 0 for zero, and +1 for positive numbers.
+func (z *Int128) Sign() int {
+	if z.value.IsZero() {
+		return 0
+	}
+	if z.value.Hi()>>63 == 0 {
+		return 1
+	}
+	return -1
+}
+
+// IsZero returns true if z == 0
+func (z *Int128) IsZero() bool {
+	return z.value.IsZero()
+}
+
+// IsNeg returns true if z < 0
+func (z *Int128) IsNeg() bool {
+	return z.Sign() < 0
+}
+
+// Eq returns true if z == x
+func (z *Int128) Eq(x *Int128) bool {
+	return z.value.Eq(&x.value)
+}
+
+// Cmp compares z and x and returns:
+//
+//   - 1 if z > x
+//   - 0 if z == x
+//   - -1 if z < x
+func (z *Int128) Cmp(x *Int128) int {
+	zNeg, xNeg := z.IsNeg(), x.IsNeg()
+	switch {
+	case zNeg && !xNeg:
+		return -1
+	case !zNeg && xNeg:
+		return 1
+	}
+	// Same sign: two's complement preserves the unsigned ordering.
+	return z.value.Cmp(&x.value)
+}
+
+// Lt returns true if z < x
+func (z *Int128) Lt(x *Int128) bool {
+	return z.Cmp(x) < 0
+}
+
+// Gt returns true if z > x
+func (z *Int128) Gt(x *Int128) bool {
+	return z.Cmp(x) > 0
+}
+
+// Neg sets z to -x and returns z.
+// -MinInt128 wraps to MinInt128.
+func (z *Int128) Neg(x *Int128) *Int128 {
+	z, _ = z.NegOverflow(x)
+	return z
+}
+
+// NegOverflow sets z to -x, and returns z and whether overflow occurred,
+// which is the case only for x == MinInt128.
+func (z *Int128) NegOverflow(x *Int128) (*Int128, bool) {
+	overflow := x.Eq(MinInt128())
+	z.value.Neg(&x.value)
+	return z, overflow
+}
+
+// Add sets z to the sum x+y, wrapping around on overflow.
+func (z *Int128) Add(x, y *Int128) *Int128 {
+	z, _ = z.AddOverflow(x, y)
+	return z
+}
+
+// AddOverflow sets z to the sum x+y, and returns z and whether signed overflow occurred.
+func (z *Int128) AddOverflow(x, y *Int128) (*Int128, bool) {
+	xNeg, yNeg := x.IsNeg(), y.IsNeg()
+	z.value.Add(&x.value, &y.value)
+	// Overflow iff both operands have the same sign and the result has the other sign.
+	return z, xNeg == yNeg && z.IsNeg() != xNeg
+}
+
+// Sub sets z to the difference x-y, wrapping around on overflow.
+func (z *Int128) Sub(x, y *Int128) *Int128 {
+	z, _ = z.SubOverflow(x, y)
+	return z
+}
+
+// SubOverflow sets z to the difference x-y, and returns z and whether signed overflow occurred.
+func (z *Int128) SubOverflow(x, y *Int128) (*Int128, bool) {
+	xNeg, yNeg := x.IsNeg(), y.IsNeg()
+	z.value.Sub(&x.value, &y.value)
+	// Overflow iff the operands have different signs and the result has the sign of y.
+	return z, xNeg != yNeg && z.IsNeg() != xNeg
+}
+
+// Mul sets z to the product x*y, wrapping around on overflow.
+func (z *Int128) Mul(x, y *Int128) *Int128 {
+	z, _ = z.MulOverflow(x, y)
+	return z
+}
+
+// MulOverflow sets z to the product x*y, and returns z and whether signed overflow occurred.
+func (z *Int128) MulOverflow(x, y *Int128) (*Int128, bool) {
+	// The exact product of two int128 values always fits in an int256.
+	prod := new(Int).Mul(x.ToInt(), y.ToInt())
+	_, err := new(Int128).SetInt(prod)
+	z.value.Mul(&x.value, &y.value)
+	return z, err != nil
+}
+
+// Div sets z to the quotient x/y, truncated towards zero, and returns z.
+// If y == 0, it panics with a "division by zero" error.
+// MinInt128 / -1 wraps to MinInt128; use DivOverflow to detect this case.
+func (z *Int128) Div(x, y *Int128) *Int128 {
+	z, _ = z.DivOverflow(x, y)
+	return z
+}
+
+// DivOverflow sets z to the quotient x/y, truncated towards zero, and returns z
+// and whether overflow occurred, which is the case only for MinInt128 / -1.
+// If y == 0, it panics with a "division by zero" error.
+func (z *Int128) DivOverflow(x, y *Int128) (*Int128, bool) {
+	if y.IsZero() {
+		panic(divisionByZeroError)
+	}
+	overflow := x.Eq(MinInt128()) && y.Eq(NewInt128(-1))
+	quot := new(Int).Div(x.ToInt(), y.ToInt())
+	z.value.SetUintOverflow(&quot.value)
+	return z, overflow
+}
+
+// Quo sets z to the quotient x/y for y != 0 and returns z.
+// Like Int.Quo, the quotient is rounded towards negative infinity.
+// If y == 0, it panics with a "division by zero" error.
+// MinInt128 / -1 wraps to MinInt128.
+func (z *Int128) Quo(x, y *Int128) *Int128 {
+	if y.IsZero() {
+		panic(divisionByZeroError)
+	}
+	quot := new(Int).Quo(x.ToInt(), y.ToInt())
+	z.value.SetUintOverflow(&quot.value)
+	return z
+}
+
+// Rem sets z to the remainder x%y for y != 0, with the sign of x, and returns z.
+// If y == 0, it panics with a "division by zero" error.
+func (z *Int128) Rem(x, y *Int128) *Int128 {
+	if y.IsZero() {
+		panic(divisionByZeroError)
+	}
+	rem := new(Int).Rem(x.ToInt(), y.ToInt())
+	z.value.SetUintOverflow(&rem.value)
+	return z
+}
+
+// Mod sets z to the modulus x%y for y != 0 and returns z.
+// Like Int.Mod, it implements Euclidean modulus, so the result is never negative.
+// If y == 0, it panics with a "division by zero" error.
+func (z *Int128) Mod(x, y *Int128) *Int128 {
+	if y.IsZero() {
+		panic(divisionByZeroError)
+	}
+	mod := new(Int).Mod(x.ToInt(), y.ToInt())
+	z.value.SetUintOverflow(&mod.value)
+	return z
+}
+
+// ToString returns a string representation of z in base 10.
+// The string is prefixed with a minus sign if z is negative.
+func (z *Int128) ToString() string {
+	return z.ToInt().ToString()
+}
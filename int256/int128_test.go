@@ -0,0 +1,200 @@
+package int256
+
+import (
+	"testing"
+
+	"github.com/gnoswap-labs/uint256"
+)
+
+const (
+	maxInt128Dec = "170141183460469231731687303715884105727"
+	minInt128Dec = "-170141183460469231731687303715884105728"
+)
+
+func mustInt128(s string) *Int128 {
+	z, err := Int128FromInt(MustFromDecimal(s))
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+func TestInt128FromInt(t *testing.T) {
+	tests := []struct {
+		x       string
+		wantErr bool
+	}{
+		{"0", false},
+		{"-1", false},
+		{"-887272", false},
+		{maxInt128Dec, false},
+		{minInt128Dec, false},
+		{"170141183460469231731687303715884105728", true},
+		{"-170141183460469231731687303715884105729", true},
+		{"340282366920938463463374607431768211455", true},
+	}
+
+	for _, tt := range tests {
+		got, err := Int128FromInt(MustFromDecimal(tt.x))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Int128FromInt(%s) error = %v, wantErr %v", tt.x, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.ToString() != tt.x {
+			t.Errorf("Int128FromInt(%s).ToString() = %s", tt.x, got.ToString())
+		}
+	}
+
+	if got := MaxInt128().ToString(); got != maxInt128Dec {
+		t.Errorf("MaxInt128() = %s, want %s", got, maxInt128Dec)
+	}
+	if got := MinInt128().ToString(); got != minInt128Dec {
+		t.Errorf("MinInt128() = %s, want %s", got, minInt128Dec)
+	}
+	if got := NewInt128(-5).ToString(); got != "-5" {
+		t.Errorf("NewInt128(-5) = %s, want -5", got)
+	}
+}
+
+func TestInt128_Uint128(t *testing.T) {
+	u := uint256.MaxUint128()
+	if _, err := new(Int128).SetUint128(u); err != ErrInt128Range {
+		t.Errorf("SetUint128(%s) error = %v, want %v", u.Dec(), err, ErrInt128Range)
+	}
+
+	z, err := new(Int128).SetUint128(uint256.NewUint128(42))
+	if err != nil || z.ToString() != "42" {
+		t.Errorf("SetUint128(42) = (%s, %v)", z.ToString(), err)
+	}
+	if v, err := z.ToUint128(); err != nil || v.Dec() != "42" {
+		t.Errorf("ToUint128(42) = (%v, %v)", v, err)
+	}
+	if _, err := NewInt128(-1).ToUint128(); err != ErrInt128Range {
+		t.Errorf("ToUint128(-1) error = %v, want %v", err, ErrInt128Range)
+	}
+}
+
+func TestInt128_Arithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(z, x, y *Int128) (*Int128, bool)
+		x, y     string
+		want     string
+		overflow bool
+	}{
+		{"AddOverflow", (*Int128).AddOverflow, "1", "-2", "-1", false},
+		{"AddOverflow", (*Int128).AddOverflow, maxInt128Dec, "1", minInt128Dec, true},
+		{"AddOverflow", (*Int128).AddOverflow, minInt128Dec, "-1", maxInt128Dec, true},
+		{"AddOverflow", (*Int128).AddOverflow, minInt128Dec, maxInt128Dec, "-1", false},
+		{"SubOverflow", (*Int128).SubOverflow, "1", "2", "-1", false},
+		{"SubOverflow", (*Int128).SubOverflow, minInt128Dec, "1", maxInt128Dec, true},
+		{"SubOverflow", (*Int128).SubOverflow, "0", minInt128Dec, minInt128Dec, true},
+		{"SubOverflow", (*Int128).SubOverflow, "-1", minInt128Dec, maxInt128Dec, false},
+		{"MulOverflow", (*Int128).MulOverflow, "-3", "7", "-21", false},
+		{"MulOverflow", (*Int128).MulOverflow, minInt128Dec, "-1", minInt128Dec, true},
+		{"MulOverflow", (*Int128).MulOverflow, minInt128Dec, "1", minInt128Dec, false},
+		{"MulOverflow", (*Int128).MulOverflow, "-9223372036854775808", "18446744073709551616", minInt128Dec, false}, // -2^63 * 2^64
+		{"MulOverflow", (*Int128).MulOverflow, "9223372036854775808", "18446744073709551616", minInt128Dec, true},   // 2^63 * 2^64
+		{"DivOverflow", (*Int128).DivOverflow, "-7", "2", "-3", false},
+		{"DivOverflow", (*Int128).DivOverflow, minInt128Dec, "1", minInt128Dec, false},
+		{"DivOverflow", (*Int128).DivOverflow, minInt128Dec, "-1", minInt128Dec, true},
+		{"DivOverflow", (*Int128).DivOverflow, maxInt128Dec, "-1", "-" + maxInt128Dec, false},
+	}
+
+	for _, tt := range tests {
+		x := mustInt128(tt.x)
+		y := mustInt128(tt.y)
+
+		got, overflow := tt.fn(new(Int128), x, y)
+
+		if got.ToString() != tt.want || overflow != tt.overflow {
+			t.Errorf("%s(%s, %s) = (%s, %v), want (%s, %v)", tt.name, tt.x, tt.y, got.ToString(), overflow, tt.want, tt.overflow)
+		}
+	}
+}
+
+func TestInt128_NegOverflow(t *testing.T) {
+	tests := []struct {
+		x        string
+		want     string
+		overflow bool
+	}{
+		{"0", "0", false},
+		{"5", "-5", false},
+		{maxInt128Dec, "-" + maxInt128Dec, false},
+		{minInt128Dec, minInt128Dec, true},
+	}
+
+	for _, tt := range tests {
+		got, overflow := new(Int128).NegOverflow(mustInt128(tt.x))
+
+		if got.ToString() != tt.want || overflow != tt.overflow {
+			t.Errorf("NegOverflow(%s) = (%s, %v), want (%s, %v)", tt.x, got.ToString(), overflow, tt.want, tt.overflow)
+		}
+	}
+}
+
+func TestInt128_DivRem(t *testing.T) {
+	tests := []struct {
+		x, y    string
+		wantDiv string
+		wantRem string
+		wantQuo string
+		wantMod string
+	}{
+		{"7", "2", "3", "1", "3", "1"},
+		{"-7", "2", "-3", "-1", "-4", "1"},
+		{"7", "-2", "-3", "1", "-4", "1"},
+		{"-7", "-2", "3", "-1", "3", "1"},
+		{minInt128Dec, "-1", minInt128Dec, "0", minInt128Dec, "0"},
+		{minInt128Dec, maxInt128Dec, "-1", "-1", "-2", "170141183460469231731687303715884105726"},
+	}
+
+	for _, tt := range tests {
+		x := mustInt128(tt.x)
+		y := mustInt128(tt.y)
+
+		if got := new(Int128).Div(x, y); got.ToString() != tt.wantDiv {
+			t.Errorf("Div(%s, %s) = %s, want %s", tt.x, tt.y, got.ToString(), tt.wantDiv)
+		}
+		if got := new(Int128).Rem(x, y); got.ToString() != tt.wantRem {
+			t.Errorf("Rem(%s, %s) = %s, want %s", tt.x, tt.y, got.ToString(), tt.wantRem)
+		}
+		if got := new(Int128).Quo(x, y); got.ToString() != tt.wantQuo {
+			t.Errorf("Quo(%s, %s) = %s, want %s", tt.x, tt.y, got.ToString(), tt.wantQuo)
+		}
+		if got := new(Int128).Mod(x, y); got.ToString() != tt.wantMod {
+			t.Errorf("Mod(%s, %s) = %s, want %s", tt.x, tt.y, got.ToString(), tt.wantMod)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Div by zero did not panic")
+		}
+	}()
+	new(Int128).Div(NewInt128(1), NewInt128(0))
+}
+
+func TestInt128_Cmp(t *testing.T) {
+	tests := []struct {
+		x, y string
+		want int
+	}{
+		{"0", "0", 0},
+		{"-1", "0", -1},
+		{"0", "-1", 1},
+		{"-2", "-1", -1},
+		{minInt128Dec, maxInt128Dec, -1},
+		{maxInt128Dec, minInt128Dec, 1},
+	}
+
+	for _, tt := range tests {
+		x := mustInt128(tt.x)
+		y := mustInt128(tt.y)
+
+		if got := x.Cmp(y); got != tt.want {
+			t.Errorf("Cmp(%s, %s) = %d, want %d", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
@@ -6,21 +6,13 @@ import (
 	"github.com/gnoswap-labs/uint256"
 )
 
-var (
-	int1 = NewInt(1)
-	uint0 = uint256.NewUint(0)
-	uint1 = uint256.NewUint(1)
-)
-
 type Int struct {
 	value uint256.Uint
 }
 
 // New creates and returns a new Int initialized to zero.
 func New() *Int {
-	return &Int{
-		value: *uint0,
-	}
+	return &Int{}
 }
 
 // NewInt allocates and returns a new Int set to the value of the provided int64.
@@ -38,7 +30,22 @@ func Zero() *Int { return &Int{} }
 //
 // This function is convenient for operations that require a unit value,
 // such as incrementing or serving as an identity element in multiplication.
-func One() *Int { return int1 }
+// Each call returns a fresh copy, so the result may be freely mutated.
+func One() *Int { return NewInt(1) }
+
+// MaxInt256 returns a new Int set to 2^255 - 1.
+func MaxInt256() *Int {
+	z := new(Int)
+	z.value.SetAllOne().Rsh(&z.value, 1)
+	return z
+}
+
+// MinInt256 returns a new Int set to -2^255.
+func MinInt256() *Int {
+	z := new(Int)
+	z.value.SetOne().Lsh(&z.value, 255)
+	return z
+}
 
 // Sign determines the sign of the Int.
 //
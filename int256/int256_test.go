@@ -1,6 +1,8 @@
 package int256
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/gnoswap-labs/uint256"
@@ -16,6 +18,8 @@ func TestInitializers(t *testing.T) {
 		{"Zero", Zero, 0, "0"},
 		{"New", New, 0, "0"},
 		{"One", One, 1, "1"},
+		{"MaxInt256", MaxInt256, 1, maxInt256Dec},
+		{"MinInt256", MinInt256, -1, minInt256Dec},
 	}
 
 	for _, tt := range tests {
@@ -31,6 +35,27 @@ func TestInitializers(t *testing.T) {
 	}
 }
 
+func TestInitializers_Immutable(t *testing.T) {
+	for _, fn := range []func() *Int{Zero, New, One, MaxInt256, MinInt256} {
+		want := fn().ToString()
+
+		// mutate the returned value in place, the way a careless caller might
+		fn().Add(fn(), NewInt(12345))
+		z := fn()
+		z.Neg(z)
+
+		if got := fn().ToString(); got != want {
+			t.Errorf("mutation leaked: got %s, want %s", got, want)
+		}
+	}
+
+	// Div rounds through One internally and must not be affected by callers either
+	One().SetInt64(100)
+	if got := New().DivE(NewInt(-7), NewInt(2)).ToString(); got != "-4" {
+		t.Errorf("DivE(-7, 2) = %s, want -4", got)
+	}
+}
+
 func TestNewInt(t *testing.T) {
 	testCases := []struct {
 		input    int64
@@ -218,3 +243,62 @@ func TestSetAndToString(t *testing.T) {
 		}
 	}
 }
+
+func TestSetFromString(t *testing.T) {
+	lenient := uint256.ParseOptions{AllowUnderscore: true, AllowLeadingZeroHex: true, AllowSign: true}
+	tests := []struct {
+		s    string
+		opts uint256.ParseOptions
+		want string // empty if an error is expected
+	}{
+		{"-1000", uint256.ParseOptions{}, "-1000"},
+		{"-0x10", uint256.ParseOptions{}, "-16"},
+		{"+1000", uint256.ParseOptions{}, ""},
+		{"-1_000", uint256.ParseOptions{}, ""},
+		{"-1_000", lenient, "-1000"},
+		{"+1_000", lenient, "1000"},
+		{"-0x00_ff", lenient, "-255"},
+		{"--1", lenient, ""},
+		{"-+1", lenient, ""},
+		{"-", lenient, ""},
+		{"-0x8000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000", lenient, "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{"0x8000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000_0000", lenient, ""},
+	}
+
+	for _, tt := range tests {
+		got, err := FromString(tt.s, tt.opts)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("FromString(%q, %+v) = %s, want error", tt.s, tt.opts, got.ToString())
+			}
+			continue
+		}
+		if err != nil || got.ToString() != tt.want {
+			t.Errorf("FromString(%q, %+v) = (%v, %v), want %s", tt.s, tt.opts, got, err, tt.want)
+		}
+	}
+
+	errTests := []struct {
+		s    string
+		want error
+	}{
+		{"", ErrEmptyInput},
+		{"-", ErrInvalidNumber},
+		{"--1", ErrInvalidNumber},
+		{"-+1", ErrInvalidNumber},
+		{"-0x", ErrInvalidNumber},
+		{"-1__0", ErrInvalidNumber},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", ErrRange},
+		{"-0x1" + strings.Repeat("0", 64), ErrRange},
+	}
+	for _, tt := range errTests {
+		_, err := FromString(tt.s, lenient)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("FromString(%q) error = %v, want %v", tt.s, err, tt.want)
+			continue
+		}
+		if want := "SetFromString: " + tt.s + ": " + tt.want.Error(); err.Error() != want {
+			t.Errorf("FromString(%q) error = %q, want %q", tt.s, err, want)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// log contains integer logarithm functions for Uint instances.
+// The results are exact, floored to the nearest integer, and are useful for sizing
+// decimal output or locating the most significant bit of a value.
+package uint256
+
+// pows10 holds the powers of ten that fit in 256 bits, used by Log10
+var pows10 = [78]Uint{
+	{[4]uint64{1, 0, 0, 0}},                                                            // 10 ^ 0
+	{[4]uint64{10, 0, 0, 0}},                                                           // 10 ^ 1
+	{[4]uint64{100, 0, 0, 0}},                                                          // 10 ^ 2
+	{[4]uint64{1000, 0, 0, 0}},                                                         // 10 ^ 3
+	{[4]uint64{10000, 0, 0, 0}},                                                        // 10 ^ 4
+	{[4]uint64{100000, 0, 0, 0}},                                                       // 10 ^ 5
+	{[4]uint64{1000000, 0, 0, 0}},                                                      // 10 ^ 6
+	{[4]uint64{10000000, 0, 0, 0}},                                                     // 10 ^ 7
+	{[4]uint64{100000000, 0, 0, 0}},                                                    // 10 ^ 8
+	{[4]uint64{1000000000, 0, 0, 0}},                                                   // 10 ^ 9
+	{[4]uint64{10000000000, 0, 0, 0}},                                                  // 10 ^ 10
+	{[4]uint64{100000000000, 0, 0, 0}},                                                 // 10 ^ 11
+	{[4]uint64{1000000000000, 0, 0, 0}},                                                // 10 ^ 12
+	{[4]uint64{10000000000000, 0, 0, 0}},                                               // 10 ^ 13
+	{[4]uint64{100000000000000, 0, 0, 0}},                                              // 10 ^ 14
+	{[4]uint64{1000000000000000, 0, 0, 0}},                                             // 10 ^ 15
+	{[4]uint64{10000000000000000, 0, 0, 0}},                                            // 10 ^ 16
+	{[4]uint64{100000000000000000, 0, 0, 0}},                                           // 10 ^ 17
+	{[4]uint64{1000000000000000000, 0, 0, 0}},                                          // 10 ^ 18
+	{[4]uint64{10000000000000000000, 0, 0, 0}},                                         // 10 ^ 19
+	{[4]uint64{7766279631452241920, 5, 0, 0}},                                          // 10 ^ 20
+	{[4]uint64{3875820019684212736, 54, 0, 0}},                                         // 10 ^ 21
+	{[4]uint64{1864712049423024128, 542, 0, 0}},                                        // 10 ^ 22
+	{[4]uint64{200376420520689664, 5421, 0, 0}},                                        // 10 ^ 23
+	{[4]uint64{2003764205206896640, 54210, 0, 0}},                                      // 10 ^ 24
+	{[4]uint64{1590897978359414784, 542101, 0, 0}},                                     // 10 ^ 25
+	{[4]uint64{15908979783594147840, 5421010, 0, 0}},                                   // 10 ^ 26
+	{[4]uint64{11515845246265065472, 54210108, 0, 0}},                                  // 10 ^ 27
+	{[4]uint64{4477988020393345024, 542101086, 0, 0}},                                  // 10 ^ 28
+	{[4]uint64{7886392056514347008, 5421010862, 0, 0}},                                 // 10 ^ 29
+	{[4]uint64{5076944270305263616, 54210108624, 0, 0}},                                // 10 ^ 30
+	{[4]uint64{13875954555633532928, 542101086242, 0, 0}},                              // 10 ^ 31
+	{[4]uint64{9632337040368467968, 5421010862427, 0, 0}},                              // 10 ^ 32
+	{[4]uint64{4089650035136921600, 54210108624275, 0, 0}},                             // 10 ^ 33
+	{[4]uint64{4003012203950112768, 542101086242752, 0, 0}},                            // 10 ^ 34
+	{[4]uint64{3136633892082024448, 5421010862427522, 0, 0}},                           // 10 ^ 35
+	{[4]uint64{12919594847110692864, 54210108624275221, 0, 0}},                         // 10 ^ 36
+	{[4]uint64{68739955140067328, 542101086242752217, 0, 0}},                           // 10 ^ 37
+	{[4]uint64{687399551400673280, 5421010862427522170, 0, 0}},                         // 10 ^ 38
+	{[4]uint64{6873995514006732800, 17316620476856118468, 2, 0}},                       // 10 ^ 39
+	{[4]uint64{13399722918938673152, 7145508105175220139, 29, 0}},                      // 10 ^ 40
+	{[4]uint64{4870020673419870208, 16114848830623546549, 293, 0}},                     // 10 ^ 41
+	{[4]uint64{11806718586779598848, 13574535716559052564, 2938, 0}},                   // 10 ^ 42
+	{[4]uint64{7386721425538678784, 6618148649623664334, 29387, 0}},                    // 10 ^ 43
+	{[4]uint64{80237960548581376, 10841254275107988496, 293873, 0}},                    // 10 ^ 44
+	{[4]uint64{802379605485813760, 16178822382532126880, 2938735, 0}},                  // 10 ^ 45
+	{[4]uint64{8023796054858137600, 14214271235644855872, 29387358, 0}},                // 10 ^ 46
+	{[4]uint64{6450984253743169536, 13015503840481697412, 293873587, 0}},               // 10 ^ 47
+	{[4]uint64{9169610316303040512, 1027829888850112811, 2938735877, 0}},               // 10 ^ 48
+	{[4]uint64{17909126868192198656, 10278298888501128114, 29387358770, 0}},            // 10 ^ 49
+	{[4]uint64{13070572018536022016, 10549268516463523069, 293873587705, 0}},           // 10 ^ 50
+	{[4]uint64{1578511669393358848, 13258964796087472617, 2938735877055, 0}},           // 10 ^ 51
+	{[4]uint64{15785116693933588480, 3462439444907864858, 29387358770557, 0}},          // 10 ^ 52
+	{[4]uint64{10277214349659471872, 16177650375369096972, 293873587705571, 0}},        // 10 ^ 53
+	{[4]uint64{10538423128046960640, 14202551164014556797, 2938735877055718, 0}},       // 10 ^ 54
+	{[4]uint64{13150510911921848320, 12898303124178706663, 29387358770557187, 0}},      // 10 ^ 55
+	{[4]uint64{2377900603251621888, 18302566799529756941, 293873587705571876, 0}},      // 10 ^ 56
+	{[4]uint64{5332261958806667264, 17004971331911604867, 2938735877055718769, 0}},     // 10 ^ 57
+	{[4]uint64{16429131440647569408, 4029016655730084128, 10940614696847636083, 1}},    // 10 ^ 58
+	{[4]uint64{16717361816799281152, 3396678409881738056, 17172426599928602752, 15}},   // 10 ^ 59
+	{[4]uint64{1152921504606846976, 15520040025107828953, 5703569335900062977, 159}},   // 10 ^ 60
+	{[4]uint64{11529215046068469760, 7626447661401876602, 1695461137871974930, 1593}},  // 10 ^ 61
+	{[4]uint64{4611686018427387904, 2477500319180559562, 16954611378719749304, 15930}}, // 10 ^ 62
+	{[4]uint64{9223372036854775808, 6328259118096044006, 3525417123811528497, 159309}}, // 10 ^ 63
+	{[4]uint64{0, 7942358959831785217, 16807427164405733357, 1593091}},                 // 10 ^ 64
+	{[4]uint64{0, 5636613303479645706, 2053574980671369030, 15930919}},                 // 10 ^ 65
+	{[4]uint64{0, 1025900813667802212, 2089005733004138687, 159309191}},                // 10 ^ 66
+	{[4]uint64{0, 10259008136678022120, 2443313256331835254, 1593091911}},              // 10 ^ 67
+	{[4]uint64{0, 10356360998232463120, 5986388489608800929, 15930919111}},             // 10 ^ 68
+	{[4]uint64{0, 11329889613776873120, 4523652674959354447, 159309191113}},            // 10 ^ 69
+	{[4]uint64{0, 2618431695511421504, 8343038602174441244, 1593091911132}},            // 10 ^ 70
+	{[4]uint64{0, 7737572881404663424, 9643409726906205977, 15930919111324}},           // 10 ^ 71
+	{[4]uint64{0, 3588752519208427776, 4200376900514301694, 159309191113245}},          // 10 ^ 72
+	{[4]uint64{0, 17440781118374726144, 5110280857723913709, 1593091911132452}},        // 10 ^ 73
+	{[4]uint64{0, 8387114520361296896, 14209320429820033867, 15930919111324522}},       // 10 ^ 74
+	{[4]uint64{0, 10084168908774762496, 12965995782233477362, 159309191113245227}},     // 10 ^ 75
+	{[4]uint64{0, 8607968719199866880, 532749306367912313, 1593091911132452277}},       // 10 ^ 76
+	{[4]uint64{0, 12292710897160462336, 5327493063679123134, 15930919111324522770}},    // 10 ^ 77
+}
+
+// Log2 returns the log in base 2 of z, floored to the nearest integer.
+// This is the position of the most significant set bit of z.
+// OBS: This method returns 0 for 0, not -Inf.
+func (z *Uint) Log2() uint {
+	bitlen := z.BitLen()
+	if bitlen == 0 {
+		return 0
+	}
+	return uint(bitlen - 1)
+}
+
+// Log10 returns the log in base 10 of z, floored to the nearest integer.
+// OBS: This method returns 0 for 0, not -Inf.
+func (z *Uint) Log10() uint {
+	// The following algorithm is taken from "Bit twiddling hacks"
+	// https://graphics.stanford.edu/~seander/bithacks.html#IntegerLog10
+	//
+	// The idea is that log10(z) = log2(z) / log2(10)
+	// log2(z) trivially is z.BitLen()
+	// 1/log2(10) is a constant ~ 1233 / 4096. The approximation is correct up to 5 digit after
+	// the decimal point, which is enough to be off by at most one for 256-bit inputs.
+	bitlen := z.BitLen()
+	if bitlen == 0 {
+		return 0
+	}
+
+	t := (bitlen + 1) * 1233 >> 12
+	if z.Lt(&pows10[t]) {
+		return uint(t - 1)
+	}
+	return uint(t)
+}
+
+// LogBase returns the log in base b of z, floored to the nearest integer.
+// OBS: This method returns 0 if z is 0 or b is less than 2.
+func (z *Uint) LogBase(b *Uint) uint {
+	if z.IsZero() || b.LtUint64(2) {
+		return 0
+	}
+	if b.IsUint64() {
+		switch base := b.Uint64(); {
+		case base == 10:
+			return z.Log10()
+		case base&(base-1) == 0:
+			// b is a power of two: log_b(z) = log2(z) / log2(b)
+			return z.Log2() / b.Log2()
+		}
+	}
+
+	// Count how many times b can be multiplied into the running power
+	// before it exceeds z. The loop runs at most 255 times.
+	var (
+		n        uint
+		overflow bool
+		p        = b.Clone()
+	)
+	for !overflow && p.Lte(z) {
+		n++
+		p, overflow = p.MulOverflow(p, b)
+	}
+	return n
+}
@@ -0,0 +1,92 @@
+package uint256
+
+import (
+	"testing"
+)
+
+func TestLog2(t *testing.T) {
+	tests := []struct {
+		x    string
+		want uint
+	}{
+		{"0", 0},
+		{"1", 0},
+		{"2", 1},
+		{"3", 1},
+		{"1024", 10},
+		{"18446744073709551615", 63},
+		{"18446744073709551616", 64},
+		{"79228162514264337593543950336", 96},
+		{twoPow256Sub1, 255},
+	}
+
+	for _, tt := range tests {
+		x := MustFromDecimal(tt.x)
+
+		if got := x.Log2(); got != tt.want {
+			t.Errorf("Log2(%s) = %d, want %d", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestLog10(t *testing.T) {
+	if got := new(Uint).Log10(); got != 0 {
+		t.Errorf("Log10(0) = %d, want 0", got)
+	}
+
+	// Check the boundaries around every power of ten.
+	for i := range pows10 {
+		p := pows10[i].Clone()
+		if got := p.Log10(); got != uint(i) {
+			t.Errorf("Log10(%s) = %d, want %d", p.ToString(), got, i)
+		}
+		if i > 0 {
+			below := new(Uint).Sub(p, One())
+			if got := below.Log10(); got != uint(i-1) {
+				t.Errorf("Log10(%s) = %d, want %d", below.ToString(), got, i-1)
+			}
+		}
+		above := new(Uint).Add(p, One())
+		if got := above.Log10(); got != uint(i) {
+			t.Errorf("Log10(%s) = %d, want %d", above.ToString(), got, i)
+		}
+	}
+
+	if got := MustFromDecimal(twoPow256Sub1).Log10(); got != 77 {
+		t.Errorf("Log10(%s) = %d, want 77", twoPow256Sub1, got)
+	}
+}
+
+func TestLogBase(t *testing.T) {
+	tests := []struct {
+		x, b string
+		want uint
+	}{
+		{"0", "10", 0},
+		{"100", "0", 0},
+		{"100", "1", 0},
+		{"1", "3", 0},
+		{"2", "3", 0},
+		{"3", "3", 1},
+		{"80", "3", 3},
+		{"81", "3", 4},
+		{"1000", "10", 3},
+		{"999", "10", 2},
+		{"4096", "16", 3},
+		{"4095", "16", 2},
+		{"79228162514264337593543950336", "79228162514264337593543950336", 1},
+		{twoPow256Sub1, "2", 255},
+		{twoPow256Sub1, "3", 161},
+		{twoPow256Sub1, "340282366920938463463374607431768211456", 1},
+		{twoPow256Sub1, "340282366920938463463374607431768211455", 2},
+	}
+
+	for _, tt := range tests {
+		x := MustFromDecimal(tt.x)
+		b := MustFromDecimal(tt.b)
+
+		if got := x.LogBase(b); got != tt.want {
+			t.Errorf("LogBase(%s, %s) = %d, want %d", tt.x, tt.b, got, tt.want)
+		}
+	}
+}
@@ -603,3 +603,202 @@ func reduce4(x [8]uint64, m *Uint, mu [5]uint64) (z Uint) {
 
 	return z
 }
+
+// Modulus is a reduction context for a fixed modulus m.
+// For m >= 2^192 it caches the Barrett reciprocal of m, so that callers that
+// repeatedly reduce by the same modulus pay for the Newton iteration only once.
+// Smaller moduli, which Reciprocal does not support, are reduced with schoolbook division.
+//
+// If m == 0, every operation sets z to 0 (OBS: differs from the big.Int)
+type Modulus struct {
+	m  Uint
+	mu [5]uint64 // reciprocal of m, only valid when m.arr[3] != 0
+}
+
+// NewModulus returns a new reduction context for the modulus m.
+func NewModulus(m *Uint) *Modulus {
+	md := &Modulus{m: *m}
+	if m.arr[3] != 0 {
+		md.mu = Reciprocal(m)
+	}
+	return md
+}
+
+// Value returns a copy of the modulus of md.
+func (md *Modulus) Value() *Uint {
+	return md.m.Clone()
+}
+
+// Reduce sets z to x mod m, and returns z.
+func (md *Modulus) Reduce(z, x *Uint) *Uint {
+	return z.Mod(x, &md.m)
+}
+
+// Reduce512 sets z to x mod m, where x is a 512-bit value given as 8 little-endian words,
+// and returns z.
+func (md *Modulus) Reduce512(z *Uint, x [8]uint64) *Uint {
+	if md.m.IsZero() {
+		return z.Clear()
+	}
+	if md.m.arr[3] != 0 {
+		*z = reduce4(x, &md.m, md.mu)
+		return z
+	}
+	if (x[4] | x[5] | x[6] | x[7]) == 0 {
+		lo := Uint{arr: [4]uint64{x[0], x[1], x[2], x[3]}}
+		return z.Mod(&lo, &md.m)
+	}
+	var quot [8]uint64
+	*z = udivrem(quot[:], x[:], &md.m)
+	return z
+}
+
+// MulMod sets z to x*y mod m, and returns z.
+func (md *Modulus) MulMod(z, x, y *Uint) *Uint {
+	return md.Reduce512(z, umul(x, y))
+}
+
+// AddMod sets z to x+y mod m, and returns z.
+func (md *Modulus) AddMod(z, x, y *Uint) *Uint {
+	if md.m.IsZero() {
+		return z.Clear()
+	}
+	var a, b Uint
+	md.Reduce(&a, x)
+	md.Reduce(&b, y)
+
+	// a, b < m, hence a+b < 2m and a single subtraction of m suffices.
+	// If the sum carried out of 256 bits, the subtraction wraps back into range.
+	if _, carry := z.AddOverflow(&a, &b); carry || !z.Lt(&md.m) {
+		z.Sub(z, &md.m)
+	}
+	return z
+}
+
+// SubMod sets z to x-y mod m, and returns z.
+// The result is always the least non-negative residue.
+func (md *Modulus) SubMod(z, x, y *Uint) *Uint {
+	if md.m.IsZero() {
+		return z.Clear()
+	}
+	var a, b Uint
+	md.Reduce(&a, x)
+	md.Reduce(&b, y)
+
+	if _, borrow := z.SubOverflow(&a, &b); borrow {
+		z.Add(z, &md.m)
+	}
+	return z
+}
+
+// GCD sets z to the greatest common divisor of x and y, and returns z.
+// GCD(x, 0) = GCD(0, x) = x
+func (z *Uint) GCD(x, y *Uint) *Uint {
+	if x.IsZero() {
+		return z.Set(y)
+	}
+	if y.IsZero() {
+		return z.Set(x)
+	}
+
+	// Binary GCD (Stein's algorithm): strip the common power of two,
+	// then repeatedly subtract the smaller odd value from the larger one.
+	var a, b Uint
+	a.Set(x)
+	b.Set(y)
+
+	shift := a.trailingZeros()
+	if tz := b.trailingZeros(); tz < shift {
+		shift = tz
+	}
+	a.Rsh(&a, a.trailingZeros())
+
+	for !b.IsZero() {
+		b.Rsh(&b, b.trailingZeros())
+		if a.Gt(&b) {
+			a, b = b, a
+		}
+		b.Sub(&b, &a)
+	}
+	return z.Lsh(&a, shift)
+}
+
+// ExtGCD sets z to the greatest common divisor of x and y, and sets a and b
+// to the magnitudes of the Bezout coefficients such that
+//
+//	(-1)^aNeg * a * x + (-1)^bNeg * b * y = z
+//
+// It returns z and the signs of the coefficients. a and b are bounded by y/z and x/z.
+// If y == 0, a is set to 1 and b to 0.
+func (z *Uint) ExtGCD(x, y, a, b *Uint) (*Uint, bool, bool) {
+	// Extended Euclidean algorithm. The signs of the coefficients strictly
+	// alternate from one iteration to the next, so only the magnitudes are
+	// tracked and the signs are derived from the parity of the iteration count:
+	//
+	//	s[i+1] = s[i-1] + q[i] * s[i]
+	//	t[i+1] = t[i-1] + q[i] * t[i]
+	var (
+		r0, r1 Uint // remainders
+		s0, s1 Uint // coefficients of x
+		t0, t1 Uint // coefficients of y
+		q, tmp Uint
+		odd    bool
+	)
+	r0.Set(x)
+	r1.Set(y)
+	s0.SetOne()
+	t1.SetOne()
+
+	for !r1.IsZero() {
+		q.DivMod(&r0, &r1, &tmp)
+		r0, r1 = r1, tmp
+
+		tmp.Mul(&q, &s1)
+		tmp.Add(&tmp, &s0)
+		s0, s1 = s1, tmp
+
+		tmp.Mul(&q, &t1)
+		tmp.Add(&tmp, &t0)
+		t0, t1 = t1, tmp
+
+		odd = !odd
+	}
+
+	z.Set(&r0)
+	a.Set(&s0)
+	b.Set(&t0)
+	return z, odd && !a.IsZero(), !odd && !b.IsZero()
+}
+
+// ModInverse sets z to the multiplicative inverse of x modulo m, and returns z
+// and whether the inverse exists.
+// The inverse exists if and only if x and m are coprime. If it does not exist,
+// or if m == 0, z is set to 0.
+func (z *Uint) ModInverse(x, m *Uint) (*Uint, bool) {
+	if m.IsZero() {
+		return z.Clear(), false
+	}
+
+	var g, a, b, xm Uint
+	xm.Mod(x, m)
+	_, aNeg, _ := g.ExtGCD(&xm, m, &a, &b)
+	if !g.Eq(One()) {
+		return z.Clear(), false
+	}
+	// |a| < m, so the negative coefficient is brought into range with a single subtraction.
+	if aNeg {
+		a.Sub(m, &a)
+	}
+	return z.Set(&a), true
+}
+
+// trailingZeros returns the number of consecutive least significant zero bits of z.
+// It returns 256 for z == 0.
+func (z *Uint) trailingZeros() uint {
+	for i, word := range z.arr {
+		if word != 0 {
+			return uint(i*64 + bits.TrailingZeros64(word))
+		}
+	}
+	return 256
+}
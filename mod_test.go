@@ -0,0 +1,197 @@
+package uint256
+
+import (
+	"testing"
+)
+
+func TestGCD(t *testing.T) {
+	tests := []binOp2Test{
+		{"0", "0", "0"},
+		{"0", "31337", "31337"},
+		{"31337", "0", "31337"},
+		{"12", "18", "6"},
+		{"17", "5", "1"},
+		{"1024", "96", "32"},
+		{"79228162514264337593543950336", "340282366920938463463374607431768211456", "79228162514264337593543950336"}, // gcd(2^96, 2^128)
+		{twoPow256Sub1, "3", "3"},
+		{twoPow256Sub1, twoPow256Sub1, twoPow256Sub1},
+		{"115792089237316195423570985008687907853269984665640564039457584007908834671663", "57896044618658097711785492504343953926634992332820282019728792003956564819968", "1"}, // secp256k1 p, 2^255
+	}
+
+	for _, tt := range tests {
+		x := MustFromDecimal(tt.x)
+		y := MustFromDecimal(tt.y)
+		want := MustFromDecimal(tt.want)
+
+		got := new(Uint).GCD(x, y)
+
+		if got.Neq(want) {
+			t.Errorf("GCD(%s, %s) = %s, want %s", tt.x, tt.y, got.ToString(), want.ToString())
+		}
+	}
+}
+
+func TestExtGCD(t *testing.T) {
+	tests := []binOp2Test{
+		{"0", "0", "0"},
+		{"0", "31337", "31337"},
+		{"31337", "0", "31337"},
+		{"240", "46", "2"},
+		{"46", "240", "2"},
+		{"17", "5", "1"},
+		{twoPow256Sub1, "3", "3"},
+		{twoPow256Sub1, "115792089237316195423570985008687907853269984665640564039457584007913129639934", "1"},
+		{"115792089237316195423570985008687907853269984665640564039457584007908834671663", "57896044618658097711785492504343953926634992332820282019728792003956564819968", "1"},
+	}
+
+	for _, tt := range tests {
+		x := MustFromDecimal(tt.x)
+		y := MustFromDecimal(tt.y)
+		want := MustFromDecimal(tt.want)
+
+		var a, b Uint
+		g, aNeg, bNeg := new(Uint).ExtGCD(x, y, &a, &b)
+
+		if g.Neq(want) {
+			t.Errorf("ExtGCD(%s, %s) = %s, want %s", tt.x, tt.y, g.ToString(), want.ToString())
+			continue
+		}
+		if aNeg && bNeg {
+			t.Errorf("ExtGCD(%s, %s): both coefficients negative", tt.x, tt.y)
+		}
+
+		// The Bezout identity holds over the integers, hence also modulo 2^256.
+		var ax, by, sum Uint
+		ax.Mul(&a, x)
+		by.Mul(&b, y)
+		switch {
+		case aNeg:
+			sum.Sub(&by, &ax)
+		case bNeg:
+			sum.Sub(&ax, &by)
+		default:
+			sum.Add(&ax, &by)
+		}
+		if sum.Neq(g) {
+			t.Errorf("ExtGCD(%s, %s): a=%s (neg=%v), b=%s (neg=%v) do not satisfy the Bezout identity",
+				tt.x, tt.y, a.ToString(), aNeg, b.ToString(), bNeg)
+		}
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	tests := []struct {
+		x, m string
+		want string
+		ok   bool
+	}{
+		{"3", "11", "4", true},
+		{"10", "17", "12", true},
+		{"14", "11", "4", true}, // x > m
+		{"1", "1", "0", true},
+		{"0", "7", "0", false},
+		{"6", "9", "0", false},
+		{"5", "0", "0", false},
+		{"2", "115792089237316195423570985008687907853269984665640564039457584007908834671663", "57896044618658097711785492504343953926634992332820282019728792003954417335832", true},
+		{"3", twoPow256Sub1, "0", false},
+		{twoPow256Sub1, "340282366920938463463374607431768211456", "340282366920938463463374607431768211455", true}, // (2^256-1)^-1 mod 2^128 = 2^128-1
+	}
+
+	for _, tt := range tests {
+		x := MustFromDecimal(tt.x)
+		m := MustFromDecimal(tt.m)
+		want := MustFromDecimal(tt.want)
+
+		got, ok := new(Uint).ModInverse(x, m)
+
+		if got.Neq(want) || ok != tt.ok {
+			t.Errorf("ModInverse(%s, %s) = (%s, %v), want (%s, %v)", tt.x, tt.m, got.ToString(), ok, want.ToString(), tt.ok)
+		}
+	}
+}
+
+func TestModulus(t *testing.T) {
+	moduli := []string{
+		"0x0",
+		"0x1",
+		"0x7",
+		"0xffffffffffffffc5",
+		"0x100000000000000000000000000000001",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffd", // < 2^192, Reciprocal is not usable
+		"0x1000000000000000000000000000000000000000000000000",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+		"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+	}
+	operands := []string{
+		"0x0",
+		"0x1",
+		"0x31337",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe",
+		"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+	}
+
+	for _, ms := range moduli {
+		m := MustFromHex(ms)
+		md := NewModulus(m)
+
+		if md.Value().Neq(m) {
+			t.Errorf("NewModulus(%s).Value() = %s", ms, md.Value().ToString())
+		}
+
+		for _, xs := range operands {
+			for _, ys := range operands {
+				x := MustFromHex(xs)
+				y := MustFromHex(ys)
+
+				want := new(Uint).MulMod(x, y, m)
+				if got := md.MulMod(new(Uint), x, y); got.Neq(want) {
+					t.Errorf("Modulus(%s).MulMod(%s, %s) = %s, want %s", ms, xs, ys, got.ToString(), want.ToString())
+				}
+				if got := md.Reduce512(new(Uint), umul(x, y)); got.Neq(want) {
+					t.Errorf("Modulus(%s).Reduce512(%s * %s) = %s, want %s", ms, xs, ys, got.ToString(), want.ToString())
+				}
+
+				want = new(Uint).AddMod(x, y, m)
+				if got := md.AddMod(new(Uint), x, y); got.Neq(want) {
+					t.Errorf("Modulus(%s).AddMod(%s, %s) = %s, want %s", ms, xs, ys, got.ToString(), want.ToString())
+				}
+
+				// (x - y) + y == x (mod m)
+				diff := md.SubMod(new(Uint), x, y)
+				if !m.IsZero() && !diff.Lt(m) {
+					t.Errorf("Modulus(%s).SubMod(%s, %s) = %s, not reduced", ms, xs, ys, diff.ToString())
+				}
+				back := md.AddMod(new(Uint), diff, y)
+				if want := md.Reduce(new(Uint), x); back.Neq(want) {
+					t.Errorf("Modulus(%s).SubMod(%s, %s) = %s, which does not add back to %s", ms, xs, ys, diff.ToString(), want.ToString())
+				}
+			}
+		}
+	}
+}
+
+func TestModulus_SubMod(t *testing.T) {
+	tests := []struct {
+		x, y, m string
+		want    string
+	}{
+		{"0x5", "0x3", "0x7", "0x2"},
+		{"0x3", "0x5", "0x7", "0x5"},
+		{"0x3", "0x5", "0x0", "0x0"},
+		{"0x0", "0x1", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e"},
+		{"0x0", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffdfffff85f"}, // -(2^256 - 1) = -0x1000003d0 mod p
+	}
+
+	for _, tt := range tests {
+		x := MustFromHex(tt.x)
+		y := MustFromHex(tt.y)
+		want := MustFromHex(tt.want)
+
+		got := NewModulus(MustFromHex(tt.m)).SubMod(new(Uint), x, y)
+
+		if got.Neq(want) {
+			t.Errorf("Modulus(%s).SubMod(%s, %s) = %s, want %s", tt.m, tt.x, tt.y, got.ToString(), want.ToString())
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// montgomery provides Montgomery modular arithmetic for a fixed odd modulus.
+// Values are kept in Montgomery form (x * 2^256 mod m), so that each modular
+// multiplication needs no division. This is the fast path for long chains of
+// modular multiplications, such as elliptic-curve field arithmetic.
+package uint256
+
+import (
+	"math/bits"
+)
+
+// MontgomeryCtx holds the precomputed constants for Montgomery arithmetic
+// modulo a fixed odd modulus m, with the Montgomery radix R = 2^256.
+type MontgomeryCtx struct {
+	m     Uint
+	n0inv uint64 // -m^-1 mod 2^64
+	r2    Uint   // R^2 mod m
+	one   Uint   // R mod m, the Montgomery form of 1
+}
+
+// NewMontgomeryCtx returns a new Montgomery context for the modulus m.
+// It returns ErrEvenModulus if m is even (including 0).
+func NewMontgomeryCtx(m *Uint) (*MontgomeryCtx, error) {
+	if m.arr[0]&1 == 0 {
+		return nil, ErrEvenModulus
+	}
+	ctx := &MontgomeryCtx{m: *m}
+
+	// Newton iteration for m^-1 mod 2^64: every step doubles the number of
+	// correct low bits, and m0 is its own inverse modulo 2^3.
+	inv := m.arr[0]
+	for i := 0; i < 5; i++ {
+		inv *= 2 - m.arr[0]*inv
+	}
+	ctx.n0inv = -inv
+
+	// R mod m = (2^256 - m) mod m
+	var r Uint
+	r.Neg(m)
+	ctx.one.Mod(&r, m)
+	ctx.r2.MulMod(&ctx.one, &ctx.one, m)
+	return ctx, nil
+}
+
+// Modulus returns a copy of the modulus of ctx.
+func (ctx *MontgomeryCtx) Modulus() *Uint {
+	return ctx.m.Clone()
+}
+
+// ToMont sets z to the Montgomery form of x, x * R mod m, and returns z.
+func (ctx *MontgomeryCtx) ToMont(z, x *Uint) *Uint {
+	var xm Uint
+	xm.Mod(x, &ctx.m)
+	return ctx.MontMul(z, &xm, &ctx.r2)
+}
+
+// FromMont sets z to the value whose Montgomery form is x, x * R^-1 mod m, and returns z.
+func (ctx *MontgomeryCtx) FromMont(z, x *Uint) *Uint {
+	return ctx.MontMul(z, x, One())
+}
+
+// MontMul sets z to the Montgomery product x * y * R^-1 mod m, and returns z.
+// x and y must be reduced modulo m.
+func (ctx *MontgomeryCtx) MontMul(z, x, y *Uint) *Uint {
+	*z = ctx.montMul(x, y)
+	return z
+}
+
+// MontSquare sets z to the Montgomery square x * x * R^-1 mod m, and returns z.
+// x must be reduced modulo m.
+func (ctx *MontgomeryCtx) MontSquare(z, x *Uint) *Uint {
+	*z = ctx.montMul(x, x)
+	return z
+}
+
+// MontExp sets z to base**exponent in Montgomery form, and returns z.
+// base must be in Montgomery form, and so is the result.
+func (ctx *MontgomeryCtx) MontExp(z, base, exponent *Uint) *Uint {
+	var (
+		res        = ctx.one
+		multiplier = *base
+		expBitLen  = exponent.BitLen()
+	)
+	for curBit := 0; curBit < expBitLen; curBit++ {
+		if exponent.isBitSet(uint(curBit)) {
+			res = ctx.montMul(&res, &multiplier)
+		}
+		multiplier = ctx.montMul(&multiplier, &multiplier)
+	}
+	return z.Set(&res)
+}
+
+// montMul computes x * y * R^-1 mod m using the Coarsely Integrated Operand
+// Scanning (CIOS) method, interleaving multiplication and reduction word by word.
+// See Koc, Acar, Kaliski, "Analyzing and Comparing Montgomery Multiplication Algorithms".
+func (ctx *MontgomeryCtx) montMul(x, y *Uint) (z Uint) {
+	var (
+		t0, t1, t2, t3, t4, t5 uint64
+		carry, c               uint64
+		m                      = &ctx.m
+	)
+
+	for i := 0; i < 4; i++ {
+		yi := y.arr[i]
+
+		// t += x * y[i]
+		carry, t0 = umulHop(t0, x.arr[0], yi)
+		carry, t1 = umulStep(t1, x.arr[1], yi, carry)
+		carry, t2 = umulStep(t2, x.arr[2], yi, carry)
+		carry, t3 = umulStep(t3, x.arr[3], yi, carry)
+		t4, t5 = bits.Add64(t4, carry, 0)
+
+		// t = (t + u * m) / 2^64, where u is chosen so the low word cancels
+		u := t0 * ctx.n0inv
+		carry, _ = umulHop(t0, u, m.arr[0])
+		carry, t0 = umulStep(t1, u, m.arr[1], carry)
+		carry, t1 = umulStep(t2, u, m.arr[2], carry)
+		carry, t2 = umulStep(t3, u, m.arr[3], carry)
+		t3, c = bits.Add64(t4, carry, 0)
+		t4 = t5 + c
+	}
+
+	// t < 2m, so a single conditional subtraction brings it into range.
+	var b uint64
+	z.arr[0], b = bits.Sub64(t0, m.arr[0], 0)
+	z.arr[1], b = bits.Sub64(t1, m.arr[1], b)
+	z.arr[2], b = bits.Sub64(t2, m.arr[2], b)
+	z.arr[3], b = bits.Sub64(t3, m.arr[3], b)
+	_, b = bits.Sub64(t4, 0, b)
+	if b != 0 {
+		z.arr[0], z.arr[1], z.arr[2], z.arr[3] = t0, t1, t2, t3
+	}
+	return z
+}
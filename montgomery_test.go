@@ -0,0 +1,78 @@
+package uint256
+
+import (
+	"testing"
+)
+
+func TestNewMontgomeryCtx(t *testing.T) {
+	for _, ms := range []string{"0x0", "0x2", "0x10", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"} {
+		if _, err := NewMontgomeryCtx(MustFromHex(ms)); err != ErrEvenModulus {
+			t.Errorf("NewMontgomeryCtx(%s) error = %v, want %v", ms, err, ErrEvenModulus)
+		}
+	}
+}
+
+func TestMontgomery(t *testing.T) {
+	moduli := []string{
+		"0x1",
+		"0x7",
+		"0xffffffffffffffc5",
+		"0x100000000000000000000000000000001",
+		"0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47", // bn254 base field
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", // secp256k1 base field
+		"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+	}
+	operands := []string{
+		"0x0",
+		"0x1",
+		"0x31337",
+		"0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd46",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e",
+		"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+	}
+
+	for _, ms := range moduli {
+		m := MustFromHex(ms)
+		ctx, err := NewMontgomeryCtx(m)
+		if err != nil {
+			t.Fatalf("NewMontgomeryCtx(%s) error = %v", ms, err)
+		}
+		if ctx.Modulus().Neq(m) {
+			t.Errorf("NewMontgomeryCtx(%s).Modulus() = %s", ms, ctx.Modulus().ToString())
+		}
+
+		for _, xs := range operands {
+			x := MustFromHex(xs)
+
+			var xm, back Uint
+			ctx.ToMont(&xm, x)
+			ctx.FromMont(&back, &xm)
+			if want := new(Uint).Mod(x, m); back.Neq(want) {
+				t.Errorf("FromMont(ToMont(%s)) mod %s = %s, want %s", xs, ms, back.ToString(), want.ToString())
+			}
+
+			var sq Uint
+			ctx.FromMont(&sq, ctx.MontSquare(&sq, &xm))
+			if want := new(Uint).MulMod(x, x, m); sq.Neq(want) {
+				t.Errorf("MontSquare(%s) mod %s = %s, want %s", xs, ms, sq.ToString(), want.ToString())
+			}
+
+			for _, ys := range operands {
+				y := MustFromHex(ys)
+
+				var ym, prod Uint
+				ctx.ToMont(&ym, y)
+				ctx.FromMont(&prod, ctx.MontMul(&prod, &xm, &ym))
+				if want := new(Uint).MulMod(x, y, m); prod.Neq(want) {
+					t.Errorf("MontMul(%s, %s) mod %s = %s, want %s", xs, ys, ms, prod.ToString(), want.ToString())
+				}
+
+				var pow Uint
+				ctx.FromMont(&pow, ctx.MontExp(&pow, &xm, y))
+				if want := new(Uint).ExpMod(x, y, m); pow.Neq(want) {
+					t.Errorf("MontExp(%s, %s) mod %s = %s, want %s", xs, ys, ms, pow.ToString(), want.ToString())
+				}
+			}
+		}
+	}
+}
@@ -5,6 +5,7 @@ package uint256
 import (
 	"errors"
 	"math/bits"
+	"strings"
 )
 
 const (
@@ -34,6 +35,34 @@ func One() *Uint {
 	return NewUint(1)
 }
 
+// The constructors below return a fresh copy on every call, so mutating the
+// result (e.g. MaxUint256().Add(...)) never affects other callers.
+
+// MaxUint256 returns a new Uint set to 2^256 - 1.
+func MaxUint256() *Uint {
+	return new(Uint).SetAllOne()
+}
+
+// Q96 returns a new Uint set to 2^96, the fixed-point scale of sqrtPriceX96 values.
+func Q96() *Uint {
+	return &Uint{arr: [4]uint64{0, 1 << 32, 0, 0}}
+}
+
+// Q128 returns a new Uint set to 2^128, the fixed-point scale of fee growth values.
+func Q128() *Uint {
+	return &Uint{arr: [4]uint64{0, 0, 1, 0}}
+}
+
+// Pow10 returns a new Uint set to 10^n.
+// It panics if n > 77, since 10^78 does not fit in 256 bits.
+func Pow10(n uint) *Uint {
+	if n >= uint(len(pows10)) {
+		panic("u256: Pow10 exponent out of range")
+	}
+	z := pows10[n]
+	return &z
+}
+
 // SetAllOne sets all the bits of z to 1
 func (z *Uint) SetAllOne() *Uint {
 	z.arr[3], z.arr[2], z.arr[1], z.arr[0] = MaxUint64, MaxUint64, MaxUint64, MaxUint64
@@ -186,6 +215,50 @@ func (z *Uint) Byte(n *Uint) *Uint {
 	return z.Clear()
 }
 
+// ExtendSign extends length of two's complement signed integer,
+// sets z to
+//   - x if byteNum > 30
+//   - x interpreted as a signed number with sign-bit at (byteNum*8+7), extended to the full 256 bits
+//
+// and returns z. This mirrors the EVM SIGNEXTEND opcode; for example byteNum = 2
+// decodes an int24 and byteNum = 15 an int128 stored in the low bytes of x.
+func (z *Uint) ExtendSign(x, byteNum *Uint) *Uint {
+	// This implementation is based on evmone. See https://github.com/ethereum/evmone/pull/390
+	if byteNum.GtUint64(30) {
+		return z.Set(x)
+	}
+
+	e := byteNum.Uint64()
+	z.Set(x)
+
+	signWordIndex := e >> 3 // Index of the word with the sign bit.
+	signByteIndex := e & 7  // Index of the sign byte in the sign word.
+	signWord := z.arr[signWordIndex]
+	signByteOffset := signByteIndex * 8
+	signByte := signWord >> signByteOffset // Move sign byte to position 0.
+
+	// Sign-extend the "sign" byte and move it to the right position. Value bits are zeros.
+	sextByte := uint64(int64(int8(signByte)))
+	sext := sextByte << signByteOffset
+	signMask := uint64(MaxUint64 << signByteOffset)
+	value := signWord & ^signMask       // Reset extended bytes.
+	z.arr[signWordIndex] = sext | value // Combine the result word.
+
+	// Produce bits (all zeros or ones) for extended words. This is done by SAR of
+	// the sign-extended byte. Shift by any value 7-63 would work.
+	signEx := uint64(int64(sextByte) >> 8)
+
+	switch signWordIndex {
+	case 2:
+		z.arr[3] = signEx
+	case 1:
+		z.arr[3], z.arr[2] = signEx, signEx
+	case 0:
+		z.arr[3], z.arr[2], z.arr[1] = signEx, signEx, signEx
+	}
+	return z
+}
+
 // BitLen returns the number of bits required to represent z
 func (z *Uint) BitLen() int {
 	switch {
@@ -212,7 +285,7 @@ func (z *Uint) Clear() *Uint {
 }
 
 const (
-	// hextable  = "0123456789abcdef"
+	hextable  = "0123456789abcdef"
 	bintable  = "\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x00\x01\x02\x03\x04\x05\x06\a\b\t\xff\xff\xff\xff\xff\xff\xff\n\v\f\r\x0e\x0f\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\n\v\f\r\x0e\x0f\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff"
 	badNibble = 0xff
 )
@@ -279,6 +352,141 @@ func MustFromHex(hex string) *Uint {
 	return &z
 }
 
+// ParseOptions relaxes the strict input rules of SetFromDecimal and SetFromHex.
+// The zero value is as strict as SetFromDecimal and SetFromHex, except that no sign is accepted.
+type ParseOptions struct {
+	// AllowUnderscore accepts underscores between digits, or between the
+	// 0x prefix and a digit, following the Go literal rules (e.g. "1_000_000").
+	AllowUnderscore bool
+	// AllowLeadingZeroHex accepts hex input with leading zero digits, such as "0x0001".
+	AllowLeadingZeroHex bool
+	// AllowSign accepts a single leading "+" sign.
+	AllowSign bool
+}
+
+// SetFromString sets z from s, which is either a decimal number or a
+// 0x-prefixed hexadecimal number, applying the leniency given by opts.
+// Numbers larger than 256 bits are not accepted.
+// Errors wrap ErrEmptyInput, ErrInvalidNumber or ErrRange and quote s unchanged.
+func (z *Uint) SetFromString(s string, opts ParseOptions) error {
+	const fn = "SetFromString"
+	s0 := s
+
+	if s == "" {
+		return errEmptyInput(fn, s0)
+	}
+	if opts.AllowSign && s[0] == '+' {
+		s = s[1:]
+	}
+	if strings.IndexByte(s, '_') >= 0 {
+		if !opts.AllowUnderscore || !underscoreOK(s) {
+			return errInvalidNumber(fn, s0)
+		}
+		s = strings.ReplaceAll(s, "_", "")
+	}
+
+	hex := len(s) >= 2 && s[0] == '0' && lower(s[1]) == 'x'
+	digits := s
+	if hex {
+		digits = s[2:]
+	}
+	// validate every digit up front, so that the parsers below can only fail on range
+	if !validDigits(digits, hex) {
+		return errInvalidNumber(fn, s0)
+	}
+
+	var err error
+	if hex {
+		if len(digits) > 1 && digits[0] == '0' {
+			if !opts.AllowLeadingZeroHex {
+				return errInvalidNumber(fn, s0)
+			}
+			digits = strings.TrimLeft(digits, "0")
+			if digits == "" {
+				digits = "0"
+			}
+			s = s[:2] + digits
+		}
+		err = z.fromHex(s)
+	} else {
+		err = z.SetFromDecimal(s)
+	}
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrBig256Range):
+		return errRange(fn, s0)
+	default:
+		return errInvalidNumber(fn, s0)
+	}
+}
+
+// validDigits reports whether s is a non-empty run of decimal, or hex, digits.
+func validDigits(s string, hex bool) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if hex {
+			if bintable[s[i]] == badNibble {
+				return false
+			}
+		} else if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// FromString is a convenience-constructor to create an Uint from a
+// decimal or 0x-prefixed hexadecimal string, applying the leniency given by opts.
+func FromString(s string, opts ParseOptions) (*Uint, error) {
+	var z Uint
+	if err := z.SetFromString(s, opts); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// Hex encodes z in 0x-prefixed hexadecimal form, without leading zeros.
+// The output is accepted by SetFromHex, FromHex and UnmarshalText.
+func (z *Uint) Hex() string {
+	var buf [66]byte
+	return string(z.AppendHex(buf[:0]))
+}
+
+// AppendHex appends the Hex encoding of z to dst and returns the extended buffer.
+// No allocation takes place if dst has room for up to 66 more bytes.
+func (z *Uint) AppendHex(dst []byte) []byte {
+	return z.appendHex(dst, 1)
+}
+
+// PaddedHex encodes z in 0x-prefixed hexadecimal form, left-padded with zeros to
+// n bytes (2*n hex digits), e.g. PaddedHex(32) yields a 64-digit word.
+// Values wider than n bytes are not truncated.
+// OBS: the padded output is rejected by SetFromHex, which does not accept leading zeros.
+func (z *Uint) PaddedHex(n int) string {
+	var buf [66]byte
+	return string(z.appendHex(buf[:0], 2*n))
+}
+
+// appendHex appends z in 0x-prefixed hexadecimal form with at least minDigits digits.
+func (z *Uint) appendHex(dst []byte, minDigits int) []byte {
+	nibbles := (z.BitLen() + 3) / 4
+	if nibbles < minDigits {
+		nibbles = minDigits
+	}
+	dst = append(dst, '0', 'x')
+	for i := nibbles - 1; i >= 0; i-- {
+		if i >= 64 {
+			dst = append(dst, '0')
+			continue
+		}
+		dst = append(dst, hextable[(z.arr[i/16]>>(uint(i%16)*4))&0xf])
+	}
+	return dst
+}
+
 // Clone creates a new Uint identical to z
 func (z *Uint) Clone() *Uint {
 	var x Uint
@@ -1,6 +1,8 @@
 package uint256
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -49,6 +51,215 @@ func TestByte(t *testing.T) {
 	}
 }
 
+func TestExtendSign(t *testing.T) {
+	tests := []struct {
+		x       string
+		byteNum uint64
+		want    string
+	}{
+		{"0x0", 0, "0x0"},
+		{"0x7f", 0, "0x7f"},
+		{"0x80", 0, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80"},
+		{"0xff", 0, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+		{"0x1ff", 0, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+		{"0x17f", 0, "0x7f"},
+		{"0x7fffff", 2, "0x7fffff"}, // int24 max
+		{"0x800000", 2, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffff800000"},                            // int24 min
+		{"0xabcdef12fff27618", 2, "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffff27618"},                  // int24 -887272
+		{"0x80000000000000000000000000000000", 15, "0xffffffffffffffffffffffffffffffff80000000000000000000000000000000"}, // int128 min
+		{"0x1234567f000000000000000000000000000000000000000000000000", 15, "0x0"},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", 30, "0x0"},
+		{"0x80000000000000000000000000000000000000000000000000000000000000", 30, "0xff80000000000000000000000000000000000000000000000000000000000000"},
+		{"0x80000000000000000000000000000000000000000000000000000000000000", 31, "0x80000000000000000000000000000000000000000000000000000000000000"},
+		{"0x80000000000000000000000000000000000000000000000000000000000000", 1000, "0x80000000000000000000000000000000000000000000000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		x := MustFromHex(tt.x)
+		want := MustFromHex(tt.want)
+
+		got := new(Uint).ExtendSign(x, NewUint(tt.byteNum))
+
+		if got.Neq(want) {
+			t.Errorf("ExtendSign(%s, %d) = %s, want %s", tt.x, tt.byteNum, got.ToString(), want.ToString())
+		}
+	}
+}
+
+func TestConstants(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func() *Uint
+		want string
+	}{
+		{"Zero", Zero, "0"},
+		{"One", One, "1"},
+		{"MaxUint256", MaxUint256, twoPow256Sub1},
+		{"Q96", Q96, "79228162514264337593543950336"},
+		{"Q128", Q128, "340282366920938463463374607431768211456"},
+		{"Pow10(0)", func() *Uint { return Pow10(0) }, "1"},
+		{"Pow10(18)", func() *Uint { return Pow10(18) }, "1000000000000000000"},
+		{"Pow10(77)", func() *Uint { return Pow10(77) }, "100000000000000000000000000000000000000000000000000000000000000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.fn().Dec(); got != tt.want {
+			t.Errorf("%s() = %s, want %s", tt.name, got, tt.want)
+		}
+
+		// mutate the returned value in place, the way a careless caller might
+		tt.fn().Add(tt.fn(), NewUint(12345))
+		z := tt.fn()
+		z.Clear()
+
+		if got := tt.fn().Dec(); got != tt.want {
+			t.Errorf("%s() mutation leaked: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Pow10(78) did not panic")
+		}
+	}()
+	Pow10(78)
+}
+
+func TestSetFromString(t *testing.T) {
+	var (
+		strict  = ParseOptions{}
+		lenient = ParseOptions{AllowUnderscore: true, AllowLeadingZeroHex: true, AllowSign: true}
+	)
+	tests := []struct {
+		s    string
+		opts ParseOptions
+		want string // empty if an error is expected
+	}{
+		{"1000000", strict, "1000000"},
+		{"0xff", strict, "255"},
+		{"1_000_000", strict, ""},
+		{"0x0001", strict, ""},
+		{"+1", strict, ""},
+		{"1_000_000", ParseOptions{AllowUnderscore: true}, "1000000"},
+		{"1_000_000_000_000_000_000", lenient, "1000000000000000000"},
+		{"0xdead_beef", lenient, "3735928559"},
+		{"0x_ff", lenient, "255"},
+		{"1__000", lenient, ""},
+		{"_1000", lenient, ""},
+		{"1000_", lenient, ""},
+		{"0x0001", ParseOptions{AllowLeadingZeroHex: true}, "1"},
+		{"0x0000", lenient, "0"},
+		{"0x00_01", lenient, "1"},
+		{"0x", lenient, ""},
+		{"+0x10", lenient, "16"},
+		{"+1_000", lenient, "1000"},
+		{"-1", lenient, ""},
+		{"++1", lenient, ""},
+		{"0x0000000000000000000000000000000000000000000000000000000000000000000000ff", lenient, "255"},
+		{"115_792_089_237_316_195_423_570_985_008_687_907_853_269_984_665_640_564_039_457_584_007_913_129_639_935", lenient, twoPow256Sub1},
+		{"115_792_089_237_316_195_423_570_985_008_687_907_853_269_984_665_640_564_039_457_584_007_913_129_639_936", lenient, ""},
+		{"", lenient, ""},
+	}
+
+	for _, tt := range tests {
+		got, err := FromString(tt.s, tt.opts)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("FromString(%q, %+v) = %s, want error", tt.s, tt.opts, got.Dec())
+			}
+			continue
+		}
+		if err != nil || got.Dec() != tt.want {
+			t.Errorf("FromString(%q, %+v) = (%v, %v), want %s", tt.s, tt.opts, got, err, tt.want)
+		}
+	}
+}
+
+func TestSetFromStringErrors(t *testing.T) {
+	lenient := ParseOptions{AllowUnderscore: true, AllowLeadingZeroHex: true, AllowSign: true}
+	tests := []struct {
+		s    string
+		opts ParseOptions
+		want error
+	}{
+		{"", lenient, ErrEmptyInput},
+		{"+", lenient, ErrInvalidNumber},
+		{"0x", lenient, ErrInvalidNumber},
+		{"12a", lenient, ErrInvalidNumber},
+		{"0xfg", lenient, ErrInvalidNumber},
+		{"-1", lenient, ErrInvalidNumber},
+		{"++1", lenient, ErrInvalidNumber},
+		{"+1", ParseOptions{}, ErrInvalidNumber},
+		{"1_000", ParseOptions{}, ErrInvalidNumber},
+		{"0x0001", ParseOptions{}, ErrInvalidNumber},
+		{"1" + strings.Repeat("0", 80) + "z", lenient, ErrInvalidNumber},
+		{"0x1" + strings.Repeat("0", 64), lenient, ErrRange},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639936", lenient, ErrRange},
+		{"1" + strings.Repeat("0", 80), lenient, ErrRange},
+	}
+
+	for _, tt := range tests {
+		err := new(Uint).SetFromString(tt.s, tt.opts)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("SetFromString(%q, %+v) error = %v, want %v", tt.s, tt.opts, err, tt.want)
+			continue
+		}
+		if want := "SetFromString: " + tt.s + ": " + tt.want.Error(); err.Error() != want {
+			t.Errorf("SetFromString(%q, %+v) error = %q, want %q", tt.s, tt.opts, err, want)
+		}
+	}
+}
+
+func TestHex(t *testing.T) {
+	tests := []struct {
+		x      string
+		padded string
+	}{
+		{"0x0", "0x0000000000000000000000000000000000000000000000000000000000000000"},
+		{"0x1", "0x0000000000000000000000000000000000000000000000000000000000000001"},
+		{"0xabcdef", "0x0000000000000000000000000000000000000000000000000000000000abcdef"},
+		{"0x10000000000000000", "0x0000000000000000000000000000000000000000000000010000000000000000"},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", "0x8000000000000000000000000000000000000000000000000000000000000000"},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+	}
+
+	for _, tt := range tests {
+		x := MustFromHex(tt.x)
+
+		if got := x.Hex(); got != tt.x {
+			t.Errorf("Hex(%s) = %s", tt.x, got)
+		}
+		if got := x.PaddedHex(32); got != tt.padded {
+			t.Errorf("PaddedHex(%s, 32) = %s, want %s", tt.x, got, tt.padded)
+		}
+		if got := string(x.AppendHex([]byte("x="))); got != "x="+tt.x {
+			t.Errorf("AppendHex(%s) = %s", tt.x, got)
+		}
+
+		// Hex must round-trip through the text unmarshaler
+		var back Uint
+		if err := back.UnmarshalText([]byte(x.Hex())); err != nil || back.Neq(x) {
+			t.Errorf("UnmarshalText(Hex(%s)) = (%s, %v)", tt.x, back.Hex(), err)
+		}
+	}
+
+	if got := MustFromHex("0xabc").PaddedHex(1); got != "0xabc" {
+		t.Errorf("PaddedHex(0xabc, 1) = %s, want 0xabc", got)
+	}
+	if got := MustFromHex("0xabc").PaddedHex(4); got != "0x00000abc" {
+		t.Errorf("PaddedHex(0xabc, 4) = %s, want 0x00000abc", got)
+	}
+	if got := MustFromHex("0xabc").PaddedHex(40); len(got) != 82 || got[len(got)-4:] != "0abc" {
+		t.Errorf("PaddedHex(0xabc, 40) = %s", got)
+	}
+
+	x := MustFromHex("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	buf := make([]byte, 0, 66)
+	if allocs := testing.AllocsPerRun(100, func() { buf = x.AppendHex(buf[:0]) }); allocs != 0 {
+		t.Errorf("AppendHex allocated %v times, want 0", allocs)
+	}
+}
+
 func TestBitLen(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -0,0 +1,283 @@
+// uint128 provides a 128-bit unsigned integer type, Uint128, for values such as
+// Uniswap-style liquidity which are defined as uint128. It interoperates with Uint,
+// and conversions from Uint report an error instead of silently truncating.
+package uint256
+
+import (
+	"math/bits"
+)
+
+// Uint128 is represented as an array of 2 uint64, in little-endian order,
+// so that Uint128[1] is the most significant, and Uint128[0] is the least significant
+type Uint128 struct {
+	arr [2]uint64
+}
+
+// NewUint128 returns a new initialized Uint128.
+func NewUint128(val uint64) *Uint128 {
+	return &Uint128{arr: [2]uint64{val, 0}}
+}
+
+// MaxUint128 returns a new Uint128 set to 2^128 - 1.
+func MaxUint128() *Uint128 {
+	return &Uint128{arr: [2]uint64{MaxUint64, MaxUint64}}
+}
+
+// Uint128FromUint is a convenience-constructor to create a Uint128 from a Uint.
+// Numbers larger than 128 bits are not accepted.
+func Uint128FromUint(x *Uint) (*Uint128, error) {
+	var z Uint128
+	if _, err := z.SetUint(x); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// SetUint sets z to the value of x, and returns z.
+// If x does not fit in 128 bits, z is left unchanged and ErrUint128Range is returned.
+func (z *Uint128) SetUint(x *Uint) (*Uint128, error) {
+	if (x.arr[2] | x.arr[3]) != 0 {
+		return z, ErrUint128Range
+	}
+	z.SetUintOverflow(x)
+	return z, nil
+}
+
+// SetUintOverflow sets z to the lower 128 bits of x, and returns z and whether
+// x overflowed 128 bits.
+func (z *Uint128) SetUintOverflow(x *Uint) (*Uint128, bool) {
+	z.arr[1], z.arr[0] = x.arr[1], x.arr[0]
+	return z, (x.arr[2] | x.arr[3]) != 0
+}
+
+// ToUint returns a new Uint set to the value of z.
+func (z *Uint128) ToUint() *Uint {
+	return &Uint{arr: [4]uint64{z.arr[0], z.arr[1], 0, 0}}
+}
+
+// SetUint64 sets z to the value x
+func (z *Uint128) SetUint64(x uint64) *Uint128 {
+	z.arr[1], z.arr[0] = 0, x
+	return z
+}
+
+// SetWords sets z to the value hi * 2^64 + lo, and returns z.
+func (z *Uint128) SetWords(hi, lo uint64) *Uint128 {
+	z.arr[1], z.arr[0] = hi, lo
+	return z
+}
+
+// Hi returns the upper 64 bits of z.
+func (z *Uint128) Hi() uint64 {
+	return z.arr[1]
+}
+
+// Lo returns the lower 64 bits of z.
+func (z *Uint128) Lo() uint64 {
+	return z.arr[0]
+}
+
+// Uint64 returns the lower 64-bits of z
+func (z *Uint128) Uint64() uint64 {
+	return z.arr[0]
+}
+
+// IsUint64 reports whether z can be represented as a uint64.
+func (z *Uint128) IsUint64() bool {
+	return z.arr[1] == 0
+}
+
+// Set sets z to x and returns z.
+func (z *Uint128) Set(x *Uint128) *Uint128 {
+	*z = *x
+	return z
+}
+
+// Clear sets z to 0
+func (z *Uint128) Clear() *Uint128 {
+	z.arr[1], z.arr[0] = 0, 0
+	return z
+}
+
+// IsZero returns true if z == 0
+func (z *Uint128) IsZero() bool {
+	return (z.arr[0] | z.arr[1]) == 0
+}
+
+// Cmp compares z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *Uint128) Cmp(x *Uint128) int {
+	switch {
+	case z.arr[1] < x.arr[1]:
+		return -1
+	case z.arr[1] > x.arr[1]:
+		return 1
+	case z.arr[0] < x.arr[0]:
+		return -1
+	case z.arr[0] > x.arr[0]:
+		return 1
+	}
+	return 0
+}
+
+// Eq returns true if z == x
+func (z *Uint128) Eq(x *Uint128) bool {
+	return z.arr == x.arr
+}
+
+// Lt returns true if z < x
+func (z *Uint128) Lt(x *Uint128) bool {
+	return z.Cmp(x) < 0
+}
+
+// Gt returns true if z > x
+func (z *Uint128) Gt(x *Uint128) bool {
+	return z.Cmp(x) > 0
+}
+
+// Add sets z to the sum x+y mod 2^128
+func (z *Uint128) Add(x, y *Uint128) *Uint128 {
+	z, _ = z.AddOverflow(x, y)
+	return z
+}
+
+// AddOverflow sets z to the sum x+y, and returns z and whether overflow occurred
+func (z *Uint128) AddOverflow(x, y *Uint128) (*Uint128, bool) {
+	var carry uint64
+	z.arr[0], carry = bits.Add64(x.arr[0], y.arr[0], 0)
+	z.arr[1], carry = bits.Add64(x.arr[1], y.arr[1], carry)
+	return z, carry != 0
+}
+
+// Sub sets z to the difference x-y mod 2^128
+func (z *Uint128) Sub(x, y *Uint128) *Uint128 {
+	z, _ = z.SubOverflow(x, y)
+	return z
+}
+
+// SubOverflow sets z to the difference x-y and returns z and true if the operation underflowed
+func (z *Uint128) SubOverflow(x, y *Uint128) (*Uint128, bool) {
+	var borrow uint64
+	z.arr[0], borrow = bits.Sub64(x.arr[0], y.arr[0], 0)
+	z.arr[1], borrow = bits.Sub64(x.arr[1], y.arr[1], borrow)
+	return z, borrow != 0
+}
+
+// Neg returns -x mod 2^128.
+func (z *Uint128) Neg(x *Uint128) *Uint128 {
+	return z.Sub(new(Uint128), x)
+}
+
+// Mul sets z to the product x*y mod 2^128
+func (z *Uint128) Mul(x, y *Uint128) *Uint128 {
+	z, _ = z.MulOverflow(x, y)
+	return z
+}
+
+// MulOverflow sets z to the product x*y, and returns z and whether overflow occurred
+func (z *Uint128) MulOverflow(x, y *Uint128) (*Uint128, bool) {
+	hi, lo := bits.Mul64(x.arr[0], y.arr[0])
+	h1, l1 := bits.Mul64(x.arr[1], y.arr[0])
+	h2, l2 := bits.Mul64(x.arr[0], y.arr[1])
+
+	mid, c1 := bits.Add64(hi, l1, 0)
+	mid, c2 := bits.Add64(mid, l2, 0)
+
+	overflow := (x.arr[1] != 0 && y.arr[1] != 0) || (h1|h2|c1|c2) != 0
+	z.arr[1], z.arr[0] = mid, lo
+	return z, overflow
+}
+
+// Div sets z to the quotient x/y for returns z.
+// If y == 0, z is set to 0
+func (z *Uint128) Div(x, y *Uint128) *Uint128 {
+	z, _ = z.DivMod(x, y, new(Uint128))
+	return z
+}
+
+// Mod sets z to the modulus x%y for y != 0 and returns z.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Uint128) Mod(x, y *Uint128) *Uint128 {
+	var quot Uint128
+	quot.DivMod(x, y, z)
+	return z
+}
+
+// DivMod sets z to the quotient x div y and m to the modulus x mod y and returns the pair (z, m) for y != 0.
+// If y == 0, both z and m are set to 0 (OBS: differs from the big.Int)
+func (z *Uint128) DivMod(x, y, m *Uint128) (*Uint128, *Uint128) {
+	if y.IsZero() {
+		return z.Clear(), m.Clear()
+	}
+	if y.arr[1] == 0 {
+		// Divisor fits in a single word: two chained 128/64-bit divisions.
+		var q Uint128
+		var r uint64
+		q.arr[1], r = bits.Div64(0, x.arr[1], y.arr[0])
+		q.arr[0], r = bits.Div64(r, x.arr[0], y.arr[0])
+		*z = q
+		m.SetUint64(r)
+		return z, m
+	}
+
+	// Two-word divisor: the quotient fits in a single word.
+	var quot, rem Uint
+	quot.DivMod(x.ToUint(), y.ToUint(), &rem)
+	z.arr[1], z.arr[0] = 0, quot.arr[0]
+	m.arr[1], m.arr[0] = rem.arr[1], rem.arr[0]
+	return z, m
+}
+
+// Or sets z = x | y and returns z.
+func (z *Uint128) Or(x, y *Uint128) *Uint128 {
+	z.arr[1], z.arr[0] = x.arr[1]|y.arr[1], x.arr[0]|y.arr[0]
+	return z
+}
+
+// And sets z = x & y and returns z.
+func (z *Uint128) And(x, y *Uint128) *Uint128 {
+	z.arr[1], z.arr[0] = x.arr[1]&y.arr[1], x.arr[0]&y.arr[0]
+	return z
+}
+
+// Lsh sets z = x << n and returns z.
+func (z *Uint128) Lsh(x *Uint128, n uint) *Uint128 {
+	switch {
+	case n >= 128:
+		return z.Clear()
+	case n >= 64:
+		z.arr[1], z.arr[0] = x.arr[0]<<(n-64), 0
+	default:
+		z.arr[1], z.arr[0] = x.arr[1]<<n|x.arr[0]>>(64-n), x.arr[0]<<n
+	}
+	return z
+}
+
+// Rsh sets z = x >> n and returns z.
+func (z *Uint128) Rsh(x *Uint128, n uint) *Uint128 {
+	switch {
+	case n >= 128:
+		return z.Clear()
+	case n >= 64:
+		z.arr[1], z.arr[0] = 0, x.arr[1]>>(n-64)
+	default:
+		z.arr[1], z.arr[0] = x.arr[1]>>n, x.arr[0]>>n|x.arr[1]<<(64-n)
+	}
+	return z
+}
+
+// Dec returns the decimal representation of z.
+func (z *Uint128) Dec() string {
+	return z.ToUint().Dec()
+}
+
+// ToString returns the decimal string representation of z. It returns an empty string if z is nil.
+func (z *Uint128) ToString() string {
+	if z == nil {
+		return ""
+	}
+	return z.Dec()
+}
@@ -0,0 +1,169 @@
+package uint256
+
+import (
+	"testing"
+)
+
+const maxUint128Dec = "340282366920938463463374607431768211455"
+
+func mustUint128(s string) *Uint128 {
+	z, err := Uint128FromUint(MustFromDecimal(s))
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+func TestUint128FromUint(t *testing.T) {
+	tests := []struct {
+		x       string
+		wantErr bool
+	}{
+		{"0", false},
+		{"18446744073709551616", false},
+		{maxUint128Dec, false},
+		{"340282366920938463463374607431768211456", true}, // 2^128
+		{twoPow256Sub1, true},
+	}
+
+	for _, tt := range tests {
+		got, err := Uint128FromUint(MustFromDecimal(tt.x))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Uint128FromUint(%s) error = %v, wantErr %v", tt.x, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.Dec() != tt.x {
+			t.Errorf("Uint128FromUint(%s) = %s", tt.x, got.Dec())
+		}
+	}
+
+	if MaxUint128().Dec() != maxUint128Dec {
+		t.Errorf("MaxUint128() = %s, want %s", MaxUint128().Dec(), maxUint128Dec)
+	}
+}
+
+func TestUint128_Arithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(z, x, y *Uint128) (*Uint128, bool)
+		x, y     string
+		want     string
+		overflow bool
+	}{
+		{"AddOverflow", (*Uint128).AddOverflow, "1", "2", "3", false},
+		{"AddOverflow", (*Uint128).AddOverflow, "18446744073709551615", "1", "18446744073709551616", false},
+		{"AddOverflow", (*Uint128).AddOverflow, maxUint128Dec, "1", "0", true},
+		{"SubOverflow", (*Uint128).SubOverflow, "18446744073709551616", "1", "18446744073709551615", false},
+		{"SubOverflow", (*Uint128).SubOverflow, "0", "1", maxUint128Dec, true},
+		{"MulOverflow", (*Uint128).MulOverflow, "18446744073709551615", "18446744073709551615", "340282366920938463426481119284349108225", false},
+		{"MulOverflow", (*Uint128).MulOverflow, "18446744073709551616", "18446744073709551616", "0", true},
+		{"MulOverflow", (*Uint128).MulOverflow, maxUint128Dec, "2", "340282366920938463463374607431768211454", true},
+		{"MulOverflow", (*Uint128).MulOverflow, "170141183460469231731687303715884105727", "2", "340282366920938463463374607431768211454", false},
+		{"MulOverflow", (*Uint128).MulOverflow, "36893488147419103232", "9223372036854775808", "0", true}, // 2^65 * 2^63
+	}
+
+	for _, tt := range tests {
+		x := mustUint128(tt.x)
+		y := mustUint128(tt.y)
+
+		got, overflow := tt.fn(new(Uint128), x, y)
+
+		if got.Dec() != tt.want || overflow != tt.overflow {
+			t.Errorf("%s(%s, %s) = (%s, %v), want (%s, %v)", tt.name, tt.x, tt.y, got.Dec(), overflow, tt.want, tt.overflow)
+		}
+	}
+}
+
+func TestUint128_DivMod(t *testing.T) {
+	tests := []struct {
+		x, y    string
+		wantDiv string
+		wantMod string
+	}{
+		{"31337", "0", "0", "0"},
+		{"31337", "3", "10445", "2"},
+		{maxUint128Dec, "10", "34028236692093846346337460743176821145", "5"},
+		{maxUint128Dec, "18446744073709551616", "18446744073709551615", "18446744073709551615"},
+		{maxUint128Dec, "18446744073709551617", "18446744073709551615", "0"},
+		{"18446744073709551616", maxUint128Dec, "0", "18446744073709551616"},
+		{maxUint128Dec, maxUint128Dec, "1", "0"},
+	}
+
+	for _, tt := range tests {
+		x := mustUint128(tt.x)
+		y := mustUint128(tt.y)
+
+		gotDiv, gotMod := new(Uint128).DivMod(x, y, new(Uint128))
+
+		if gotDiv.Dec() != tt.wantDiv || gotMod.Dec() != tt.wantMod {
+			t.Errorf("DivMod(%s, %s) = (%s, %s), want (%s, %s)", tt.x, tt.y, gotDiv.Dec(), gotMod.Dec(), tt.wantDiv, tt.wantMod)
+		}
+		if got := new(Uint128).Div(x, y); got.Dec() != tt.wantDiv {
+			t.Errorf("Div(%s, %s) = %s, want %s", tt.x, tt.y, got.Dec(), tt.wantDiv)
+		}
+		if got := new(Uint128).Mod(x, y); got.Dec() != tt.wantMod {
+			t.Errorf("Mod(%s, %s) = %s, want %s", tt.x, tt.y, got.Dec(), tt.wantMod)
+		}
+	}
+}
+
+func TestUint128_Bitwise(t *testing.T) {
+	x, _ := Uint128FromUint(MustFromHex("0x123456789abcdeffedcba9876543210"))
+	y, _ := Uint128FromUint(MustFromHex("0xff00ff00ff00ff00ff00ff00ff00ff00"))
+
+	if got, want := new(Uint128).And(x, y).ToUint().Hex(), "0x10045008900cd00fe00ba0076003200"; got != want {
+		t.Errorf("And = %s, want %s", got, want)
+	}
+	if got, want := new(Uint128).Or(x, y).ToUint().Hex(), "0xff23ff67ffabffefffdcff98ff54ff10"; got != want {
+		t.Errorf("Or = %s, want %s", got, want)
+	}
+
+	shifts := []struct {
+		n        uint
+		lsh, rsh string
+	}{
+		{0, "0x123456789abcdeffedcba9876543210", "0x123456789abcdeffedcba9876543210"},
+		{4, "0x123456789abcdeffedcba98765432100", "0x123456789abcdeffedcba987654321"},
+		{60, "0xffedcba9876543210000000000000000", "0x123456789abcdeff"},
+		{64, "0xfedcba98765432100000000000000000", "0x123456789abcdef"},
+		{68, "0xedcba987654321000000000000000000", "0x123456789abcde"},
+		{127, "0x0", "0x0"},
+		{128, "0x0", "0x0"},
+		{^uint(0), "0x0", "0x0"},
+	}
+	for _, tt := range shifts {
+		if got := new(Uint128).Lsh(x, tt.n).ToUint().Hex(); got != tt.lsh {
+			t.Errorf("Lsh(%d) = %s, want %s", tt.n, got, tt.lsh)
+		}
+		if got := new(Uint128).Rsh(x, tt.n).ToUint().Hex(); got != tt.rsh {
+			t.Errorf("Rsh(%d) = %s, want %s", tt.n, got, tt.rsh)
+		}
+	}
+}
+
+func TestUint128_Cmp(t *testing.T) {
+	tests := []struct {
+		x, y string
+		want int
+	}{
+		{"0", "0", 0},
+		{"1", "2", -1},
+		{"18446744073709551616", "18446744073709551615", 1},
+		{maxUint128Dec, maxUint128Dec, 0},
+	}
+
+	for _, tt := range tests {
+		x := mustUint128(tt.x)
+		y := mustUint128(tt.y)
+
+		if got := x.Cmp(y); got != tt.want {
+			t.Errorf("Cmp(%s, %s) = %d, want %d", tt.x, tt.y, got, tt.want)
+		}
+		if got := x.Lt(y); got != (tt.want < 0) {
+			t.Errorf("Lt(%s, %s) = %v", tt.x, tt.y, got)
+		}
+		if got := x.Gt(y); got != (tt.want > 0) {
+			t.Errorf("Gt(%s, %s) = %v", tt.x, tt.y, got)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// uint512 provides a 512-bit unsigned integer type for wide intermediate results,
+// such as full 256x256-bit products and fee-growth accumulators, along with the
+// operations needed to bring them back into the 256-bit range.
+package uint256
+
+import (
+	"math/bits"
+)
+
+// Uint512 is represented as an array of 8 uint64, in little-endian order,
+// so that Uint512[7] is the most significant, and Uint512[0] is the least significant
+type Uint512 struct {
+	arr [8]uint64
+}
+
+// NewUint512 returns a new Uint512 set to the value of x.
+func NewUint512(x *Uint) *Uint512 {
+	return new(Uint512).SetUint(x)
+}
+
+// SetUint sets z to the value of x, and returns z.
+func (z *Uint512) SetUint(x *Uint) *Uint512 {
+	copy(z.arr[:4], x.arr[:])
+	z.arr[4], z.arr[5], z.arr[6], z.arr[7] = 0, 0, 0, 0
+	return z
+}
+
+// SetWords sets z to the value hi * 2^256 + lo, and returns z.
+func (z *Uint512) SetWords(hi, lo *Uint) *Uint512 {
+	copy(z.arr[:4], lo.arr[:])
+	copy(z.arr[4:], hi.arr[:])
+	return z
+}
+
+// Set sets z to x and returns z.
+func (z *Uint512) Set(x *Uint512) *Uint512 {
+	*z = *x
+	return z
+}
+
+// Clear sets z to 0
+func (z *Uint512) Clear() *Uint512 {
+	z.arr = [8]uint64{}
+	return z
+}
+
+// IsZero returns true if z == 0
+func (z *Uint512) IsZero() bool {
+	return (z.arr[0] | z.arr[1] | z.arr[2] | z.arr[3] | z.arr[4] | z.arr[5] | z.arr[6] | z.arr[7]) == 0
+}
+
+// Lo returns the lower 256 bits of z.
+func (z *Uint512) Lo() *Uint {
+	return &Uint{arr: [4]uint64{z.arr[0], z.arr[1], z.arr[2], z.arr[3]}}
+}
+
+// Hi returns the upper 256 bits of z.
+func (z *Uint512) Hi() *Uint {
+	return &Uint{arr: [4]uint64{z.arr[4], z.arr[5], z.arr[6], z.arr[7]}}
+}
+
+// ToUint returns the lower 256 bits of z and whether z overflowed 256 bits.
+func (z *Uint512) ToUint() (*Uint, bool) {
+	return z.Lo(), (z.arr[4] | z.arr[5] | z.arr[6] | z.arr[7]) != 0
+}
+
+// BitLen returns the number of bits required to represent z
+func (z *Uint512) BitLen() int {
+	for i := len(z.arr) - 1; i >= 0; i-- {
+		if z.arr[i] != 0 {
+			return i*64 + bits.Len64(z.arr[i])
+		}
+	}
+	return 0
+}
+
+// Cmp compares z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *Uint512) Cmp(x *Uint512) int {
+	for i := len(z.arr) - 1; i >= 0; i-- {
+		switch {
+		case z.arr[i] < x.arr[i]:
+			return -1
+		case z.arr[i] > x.arr[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// Eq returns true if z == x
+func (z *Uint512) Eq(x *Uint512) bool {
+	return z.arr == x.arr
+}
+
+// Add sets z to the sum x+y mod 2^512, and returns z.
+func (z *Uint512) Add(x, y *Uint512) *Uint512 {
+	z, _ = z.AddOverflow(x, y)
+	return z
+}
+
+// AddOverflow sets z to the sum x+y, and returns z and whether overflow occurred
+func (z *Uint512) AddOverflow(x, y *Uint512) (*Uint512, bool) {
+	var carry uint64
+	for i := range z.arr {
+		z.arr[i], carry = bits.Add64(x.arr[i], y.arr[i], carry)
+	}
+	return z, carry != 0
+}
+
+// Sub sets z to the difference x-y mod 2^512, and returns z.
+func (z *Uint512) Sub(x, y *Uint512) *Uint512 {
+	z, _ = z.SubOverflow(x, y)
+	return z
+}
+
+// SubOverflow sets z to the difference x-y and returns z and true if the operation underflowed
+func (z *Uint512) SubOverflow(x, y *Uint512) (*Uint512, bool) {
+	var borrow uint64
+	for i := range z.arr {
+		z.arr[i], borrow = bits.Sub64(x.arr[i], y.arr[i], borrow)
+	}
+	return z, borrow != 0
+}
+
+// Mul sets z to the full 512-bit product x*y, and returns z.
+func (z *Uint512) Mul(x, y *Uint) *Uint512 {
+	z.arr = umul(x, y)
+	return z
+}
+
+// DivRem sets z to the quotient x/d and rem to the remainder x%d,
+// and returns the pair (z, rem).
+// If d == 0, both z and rem are set to 0 (OBS: differs from the big.Int)
+func (z *Uint512) DivRem(x *Uint512, d, rem *Uint) (*Uint512, *Uint) {
+	if d.IsZero() {
+		return z.Clear(), rem.Clear()
+	}
+	var quot [8]uint64
+	r := udivrem(quot[:], x.arr[:], d)
+	z.arr = quot
+	rem.Set(&r)
+	return z, rem
+}
@@ -0,0 +1,149 @@
+package uint256
+
+import (
+	"testing"
+)
+
+const maxHex = "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+
+// words512 builds a Uint512 from its upper and lower 256-bit halves given in hex.
+func words512(hi, lo string) *Uint512 {
+	return new(Uint512).SetWords(MustFromHex(hi), MustFromHex(lo))
+}
+
+func TestUint512_Mul(t *testing.T) {
+	tests := []struct {
+		x, y   string
+		hi, lo string
+	}{
+		{"0x0", maxHex, "0x0", "0x0"},
+		{"0x2", "0x3", "0x0", "0x6"},
+		{maxHex, "0x2", "0x1", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"},
+		{maxHex, maxHex, "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0x1"},
+		{"0x100000000000000000000000000000000", "0x100000000000000000000000000000000", "0x1", "0x0"}, // 2^128 * 2^128
+	}
+
+	for _, tt := range tests {
+		want := words512(tt.hi, tt.lo)
+
+		got := new(Uint512).Mul(MustFromHex(tt.x), MustFromHex(tt.y))
+
+		if !got.Eq(want) {
+			t.Errorf("Mul(%s, %s) = %v, want %v", tt.x, tt.y, got.arr, want.arr)
+		}
+		if wantHi := MustFromHex(tt.hi); got.Hi().Neq(wantHi) {
+			t.Errorf("Mul(%s, %s).Hi() = %s, want %s", tt.x, tt.y, got.Hi().ToString(), wantHi.ToString())
+		}
+		if wantLo := MustFromHex(tt.lo); got.Lo().Neq(wantLo) {
+			t.Errorf("Mul(%s, %s).Lo() = %s, want %s", tt.x, tt.y, got.Lo().ToString(), wantLo.ToString())
+		}
+	}
+}
+
+func TestUint512_AddSub(t *testing.T) {
+	tests := []struct {
+		x, y     *Uint512
+		sum      *Uint512
+		overflow bool
+	}{
+		{words512("0x0", "0x1"), words512("0x0", "0x2"), words512("0x0", "0x3"), false},
+		{words512("0x0", maxHex), words512("0x0", "0x1"), words512("0x1", "0x0"), false},
+		{words512(maxHex, maxHex), words512("0x0", "0x1"), words512("0x0", "0x0"), true},
+		{words512(maxHex, "0x0"), words512(maxHex, "0x0"), words512(
+			"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0x0"), true},
+	}
+
+	for i, tt := range tests {
+		got, overflow := new(Uint512).AddOverflow(tt.x, tt.y)
+		if !got.Eq(tt.sum) || overflow != tt.overflow {
+			t.Errorf("test %d: AddOverflow = (%v, %v), want (%v, %v)", i, got.arr, overflow, tt.sum.arr, tt.overflow)
+		}
+		if got := new(Uint512).Add(tt.x, tt.y); !got.Eq(tt.sum) {
+			t.Errorf("test %d: Add = %v, want %v", i, got.arr, tt.sum.arr)
+		}
+
+		// (x + y) - y == x, underflowing exactly when the addition overflowed
+		got, underflow := new(Uint512).SubOverflow(tt.sum, tt.y)
+		if !got.Eq(tt.x) || underflow != tt.overflow {
+			t.Errorf("test %d: SubOverflow = (%v, %v), want (%v, %v)", i, got.arr, underflow, tt.x.arr, tt.overflow)
+		}
+		if got := new(Uint512).Sub(tt.sum, tt.y); !got.Eq(tt.x) {
+			t.Errorf("test %d: Sub = %v, want %v", i, got.arr, tt.x.arr)
+		}
+	}
+}
+
+func TestUint512_DivRem(t *testing.T) {
+	tests := []struct {
+		x    *Uint512
+		d    string
+		quot *Uint512
+		rem  string
+	}{
+		{words512("0x0", "0x7"), "0x0", words512("0x0", "0x0"), "0x0"},
+		{words512("0x0", "0x7"), "0x2", words512("0x0", "0x3"), "0x1"},
+		{words512("0x1", "0x0"), "0x2", words512("0x0", "0x8000000000000000000000000000000000000000000000000000000000000000"), "0x0"},
+		{words512(maxHex, maxHex), "0x1", words512(maxHex, maxHex), "0x0"},
+		{words512(maxHex, maxHex), maxHex, words512("0x1", "0x1"), "0x0"},
+		{words512("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", "0x1"), maxHex, words512("0x0", maxHex), "0x0"},
+		{words512("0x1", "0x5"), "0x100000000000000000000000000000000", words512("0x0", "0x100000000000000000000000000000000"), "0x5"},
+	}
+
+	for i, tt := range tests {
+		d := MustFromHex(tt.d)
+		wantRem := MustFromHex(tt.rem)
+
+		quot, rem := new(Uint512).DivRem(tt.x, d, new(Uint))
+
+		if !quot.Eq(tt.quot) || rem.Neq(wantRem) {
+			t.Errorf("test %d: DivRem(%v, %s) = (%v, %s), want (%v, %s)", i, tt.x.arr, tt.d, quot.arr, rem.ToString(), tt.quot.arr, wantRem.ToString())
+		}
+	}
+}
+
+func TestUint512_ToUint(t *testing.T) {
+	tests := []struct {
+		x        *Uint512
+		want     string
+		overflow bool
+	}{
+		{words512("0x0", "0x0"), "0x0", false},
+		{NewUint512(MustFromHex(maxHex)), maxHex, false},
+		{words512("0x1", "0x2a"), "0x2a", true},
+	}
+
+	for i, tt := range tests {
+		want := MustFromHex(tt.want)
+
+		got, overflow := tt.x.ToUint()
+
+		if got.Neq(want) || overflow != tt.overflow {
+			t.Errorf("test %d: ToUint() = (%s, %v), want (%s, %v)", i, got.ToString(), overflow, want.ToString(), tt.overflow)
+		}
+	}
+}
+
+func TestUint512_Cmp(t *testing.T) {
+	tests := []struct {
+		x, y *Uint512
+		want int
+	}{
+		{words512("0x0", "0x0"), words512("0x0", "0x0"), 0},
+		{words512("0x0", maxHex), words512("0x1", "0x0"), -1},
+		{words512("0x1", "0x0"), words512("0x0", maxHex), 1},
+		{words512(maxHex, "0x1"), words512(maxHex, "0x2"), -1},
+	}
+
+	for i, tt := range tests {
+		if got := tt.x.Cmp(tt.y); got != tt.want {
+			t.Errorf("test %d: Cmp(%v, %v) = %d, want %d", i, tt.x.arr, tt.y.arr, got, tt.want)
+		}
+	}
+
+	if got := words512("0x0", "0x0").IsZero(); !got {
+		t.Errorf("IsZero() = false, want true")
+	}
+	if got := words512("0x1", "0x0").BitLen(); got != 257 {
+		t.Errorf("BitLen() = %d, want 257", got)
+	}
+}